@@ -0,0 +1,26 @@
+# rotate managed accounts by ID and by system and account names
+action "passwordsafe_rotate_managed_account" "rotate" {
+  config {
+    managed_account_ids = [10, 11]
+
+    managed_account {
+      system_name  = "server01"
+      account_name = "managed_account_01"
+    }
+
+    timeout       = 600
+    poll_interval = 30
+  }
+}
+
+# rotate the managed account credentials every time the managed system is replaced
+resource "terraform_data" "managed_system" {
+  input = passwordsafe_managed_system_by_asset.managed_system.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.passwordsafe_rotate_managed_account.rotate]
+    }
+  }
+}
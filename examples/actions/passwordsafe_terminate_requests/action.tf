@@ -0,0 +1,14 @@
+# terminate the active requests of the managed system before decommissioning it
+action "passwordsafe_terminate_requests" "managed_system" {
+  config {
+    managed_system_id = passwordsafe_managed_system_by_asset.managed_system.managed_system_id
+    reason            = "system decommissioned"
+  }
+}
+
+# terminate the active requests of a managed account
+action "passwordsafe_terminate_requests" "managed_account" {
+  config {
+    managed_account_id = 10
+  }
+}
@@ -0,0 +1,22 @@
+# test the managed account credentials once the managed system is created
+action "passwordsafe_test_credentials" "test" {
+  config {
+    managed_account_ids = [10, 11]
+
+    managed_account {
+      system_name  = "server01"
+      account_name = "managed_account_01"
+    }
+  }
+}
+
+resource "terraform_data" "managed_system" {
+  input = passwordsafe_managed_system_by_asset.managed_system.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.passwordsafe_test_credentials.test]
+    }
+  }
+}
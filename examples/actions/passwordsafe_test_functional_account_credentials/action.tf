@@ -0,0 +1,6 @@
+# test the functional account credentials
+action "passwordsafe_test_functional_account_credentials" "test" {
+  config {
+    functional_account_ids = [passwordsafe_functional_account.functional_account.id]
+  }
+}
@@ -0,0 +1,7 @@
+// resolve alias to the managed account it currently points to
+data "passwordsafe_alias_datasource" "alias" {
+  alias_name = "db_admin"
+}
+output "alias_account" {
+  value = "${data.passwordsafe_alias_datasource.alias.system_name}/${data.passwordsafe_alias_datasource.alias.account_name}"
+}
@@ -0,0 +1,8 @@
+data "passwordsafe_asset" "asset" {
+  workgroup_id = 1
+  ip_address   = "10.0.0.1"
+}
+
+output "asset_id" {
+  value = data.passwordsafe_asset.asset.asset_id
+}
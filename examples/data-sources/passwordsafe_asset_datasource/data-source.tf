@@ -5,4 +5,10 @@ data "passwordsafe_asset_datasource" "assets_list" {
 output "assets_list" {
   value = data.passwordsafe_asset_datasource.assets_list.assets[0].asset_name
 
+}
+
+// first assets of a workgroup
+data "passwordsafe_asset_datasource" "first_assets" {
+  parameter = "workgroup_name"
+  limit     = 10
 }
\ No newline at end of file
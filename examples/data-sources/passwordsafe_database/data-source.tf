@@ -0,0 +1,8 @@
+data "passwordsafe_database" "database" {
+  asset_id      = 10
+  instance_name = "primary"
+}
+
+output "database_id" {
+  value = data.passwordsafe_database.database.database_id
+}
@@ -4,3 +4,8 @@ data "passwordsafe_database_datasource" "databases_list" {
 output "database_list" {
   value = data.passwordsafe_database_datasource.databases_list.databases[1].instance_name
 }
+
+// databases of an asset
+data "passwordsafe_database_datasource" "asset_databases" {
+  asset_id = 1
+}
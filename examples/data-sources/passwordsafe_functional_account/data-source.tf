@@ -0,0 +1,7 @@
+data "passwordsafe_functional_account" "functional_account" {
+  account_name = "svc_windows"
+}
+
+output "functional_account_id" {
+  value = data.passwordsafe_functional_account.functional_account.functional_account_id
+}
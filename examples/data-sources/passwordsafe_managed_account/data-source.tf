@@ -1,4 +1,19 @@
 data "passwordsafe_managed_account" "manage_account_01" {
   system_name  = "system01"
   account_name = "managed_account02"
-}
\ No newline at end of file
+}
+
+data "passwordsafe_managed_account" "manage_account_by_id" {
+  managed_account_id = 10
+}
+
+data "passwordsafe_managed_account" "manage_account_by_system_id" {
+  managed_system_id = 1
+  account_name      = "domain/admin"
+}
+
+data "passwordsafe_managed_account" "manage_account_with_separator" {
+  system_name  = "system01"
+  account_name = "domain/admin"
+  separator    = "|"
+}
@@ -3,4 +3,11 @@ data "passwordsafe_managed_account_datasource" "managed_accounts_list" {
 }
 output "managed_accounts_list" {
   value = data.passwordsafe_managed_account_datasource.managed_accounts_list.managed_accounts[0].account_name
+}
+
+// managed accounts of a managed system, filtered by the API and paginated by the provider
+data "passwordsafe_managed_account_datasource" "system_managed_accounts" {
+  system_name = "system01"
+  type        = "system"
+  limit       = 100
 }
\ No newline at end of file
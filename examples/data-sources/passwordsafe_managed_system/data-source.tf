@@ -0,0 +1,7 @@
+data "passwordsafe_managed_system" "managed_system" {
+  system_name = "server01"
+}
+
+output "managed_system_id" {
+  value = data.passwordsafe_managed_system.managed_system.managed_system_id
+}
@@ -3,4 +3,11 @@ data "passwordsafe_managed_system_datasource" "managed_system_list" {
 }
 output "managed_system_list" {
   value = data.passwordsafe_managed_system_datasource.managed_system_list.managed_systems[0].system_name
+}
+
+// managed systems of a workgroup, filtered by the API and paginated by the provider
+data "passwordsafe_managed_system_datasource" "asset_managed_systems" {
+  type         = 1
+  workgroup_id = 1
+  limit        = 100
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "passwordsafe_platform" "windows" {
+  short_name = "windows"
+}
+
+output "windows_id" {
+  value = data.passwordsafe_platform.windows.platform_id
+}
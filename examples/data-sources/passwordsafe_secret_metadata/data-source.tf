@@ -0,0 +1,16 @@
+// get the metadata of a secret by path and title, the secret value is not returned
+data "passwordsafe_secret_metadata" "credential" {
+  path  = "folder1"
+  title = "credential"
+}
+output "secret_owners" {
+  value = data.passwordsafe_secret_metadata.credential.owners
+}
+
+// get the metadata of a secret by ID
+data "passwordsafe_secret_metadata" "secret_by_id" {
+  id = "9152f5b6-07d6-4955-175a-08db047219ce"
+}
+output "secret_folder" {
+  value = data.passwordsafe_secret_metadata.secret_by_id.folder_path
+}
@@ -0,0 +1,8 @@
+// list the versions of a secret, secret values are not returned
+data "passwordsafe_secret_versions_datasource" "versions" {
+  path  = "folder1"
+  title = "credential"
+}
+output "secret_versions" {
+  value = data.passwordsafe_secret_versions_datasource.versions.versions
+}
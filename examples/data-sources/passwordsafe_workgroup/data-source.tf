@@ -0,0 +1,7 @@
+data "passwordsafe_workgroup" "workgroup" {
+  name = "Default Workgroup"
+}
+
+output "workgroup_id" {
+  value = data.passwordsafe_workgroup.workgroup.id
+}
@@ -3,3 +3,35 @@ ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account" {
   account_name = "managed_account01"
 }
 
+
+# wait until the access request is approved when the managed account requires approval
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account_with_approval" {
+  system_name       = "system01"
+  account_name      = "managed_account02"
+  wait_for_approval = true
+  approval_timeout  = 3600
+  poll_interval     = 30
+}
+
+# get the credential of the managed account an alias currently points to
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account_by_alias" {
+  alias_name = "db_admin"
+}
+
+# get the managed account by managed account ID
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account_by_id" {
+  managed_account_id = 10
+}
+
+# get the managed account by managed system ID, useful when the account name contains "/"
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account_by_system_id" {
+  managed_system_id = 1
+  account_name      = "domain/admin"
+}
+
+# use a custom separator when the account name contains "/"
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account_with_separator" {
+  system_name  = "system01"
+  account_name = "domain/admin"
+  separator    = "|"
+}
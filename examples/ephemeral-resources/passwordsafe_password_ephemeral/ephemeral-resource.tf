@@ -0,0 +1,15 @@
+# generate a password complying with the password rule of the managed system
+ephemeral "passwordsafe_password_ephemeral" "password_from_rule" {
+  password_rule_id    = 7
+  excluded_characters = "\"'`"
+}
+
+# generate a password with the given constraints
+ephemeral "passwordsafe_password_ephemeral" "password" {
+  min_length      = 20
+  max_length      = 24
+  symbols         = "required"
+  min_symbols     = 2
+  allowed_symbols = "!@#$%"
+  first_character = "letter"
+}
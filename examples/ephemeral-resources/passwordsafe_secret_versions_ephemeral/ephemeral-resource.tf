@@ -0,0 +1,13 @@
+# list the versions of a secret and get the value of version 3
+ephemeral "passwordsafe_secret_versions_ephemeral" "previous_version" {
+  path    = "folder1"
+  title   = "credential"
+  version = 3
+}
+
+# get the value of version 2 of a text secret, versions of file secrets are not supported
+ephemeral "passwordsafe_secret_versions_ephemeral" "previous_text_version" {
+  path    = "folder1"
+  title   = "text_secret"
+  version = 2
+}
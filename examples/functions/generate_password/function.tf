@@ -0,0 +1,18 @@
+# the seed is kept in the state, the password only changes when the seed or the rule change
+resource "random_bytes" "password_seed" {
+  length = 32
+}
+
+resource "passwordsafe_functional_account" "functional_account" {
+  platform_id  = 1
+  domain_name  = "example.com"
+  account_name = "functional_account"
+  password = provider::passwordsafe::generate_password({
+    min_length          = 20
+    max_length          = 24
+    symbols             = "required"
+    allowed_symbols     = "!@#$%"
+    first_character     = "letter"
+    excluded_characters = "0Oo1lI"
+  }, random_bytes.password_seed.base64)
+}
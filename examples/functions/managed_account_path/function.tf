@@ -0,0 +1,4 @@
+# "system01/managed_account01"
+output "managed_account_path" {
+  value = provider::passwordsafe::managed_account_path("system01", "managed_account01")
+}
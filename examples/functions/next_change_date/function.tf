@@ -0,0 +1,9 @@
+# "2025-03-02T02:00:00Z"
+output "next_change_date" {
+  value = provider::passwordsafe::next_change_date("xdays", 30, "02:00", "2025-01-31T10:00:00Z")
+}
+
+# first day of the next month at the default change time, "2025-02-01T23:30:00Z"
+output "next_change_date_first" {
+  value = provider::passwordsafe::next_change_date(null, null, null, "2025-01-31")
+}
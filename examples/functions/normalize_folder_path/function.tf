@@ -0,0 +1,4 @@
+# "oauthgrp/folder1"
+output "folder_path" {
+  value = provider::passwordsafe::normalize_folder_path(" /oauthgrp// folder1 / ")
+}
@@ -0,0 +1,9 @@
+locals {
+  # { system_name = "system01", account_name = "managed_account01" }
+  managed_account = provider::passwordsafe::parse_managed_account_path("system01/managed_account01")
+}
+
+ephemeral "passwordsafe_managed_acccount_ephemeral" "managed_account" {
+  system_name  = local.managed_account.system_name
+  account_name = local.managed_account.account_name
+}
@@ -0,0 +1,9 @@
+locals {
+  # { path = "oauthgrp/folder1", title = "credential8" }
+  secret = provider::passwordsafe::parse_secret_path(var.secret_path)
+}
+
+data "passwordsafe_secret" "secret" {
+  path  = local.secret.path
+  title = local.secret.title
+}
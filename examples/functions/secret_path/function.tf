@@ -0,0 +1,9 @@
+# "oauthgrp/folder1/credential8"
+output "secret_path" {
+  value = provider::passwordsafe::secret_path("oauthgrp/folder1", "credential8")
+}
+
+# "oauthgrp-folder1-credential8"
+output "secret_path_with_separator" {
+  value = provider::passwordsafe::secret_path("oauthgrp-folder1", "credential8", "-")
+}
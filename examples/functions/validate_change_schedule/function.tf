@@ -0,0 +1,16 @@
+variable "change_schedule" {
+  type = object({
+    change_frequency_type = string
+    change_frequency_days = optional(number)
+    change_time           = optional(string)
+  })
+
+  validation {
+    condition = provider::passwordsafe::validate_change_schedule(
+      var.change_schedule.change_frequency_type,
+      var.change_schedule.change_frequency_days,
+      var.change_schedule.change_time,
+    )
+    error_message = "Invalid change schedule."
+  }
+}
@@ -0,0 +1,8 @@
+list "passwordsafe_asset_by_workgroup_id" "web_servers" {
+  provider = passwordsafe
+
+  config {
+    work_group_id = "1"
+    name_pattern  = "web*"
+  }
+}
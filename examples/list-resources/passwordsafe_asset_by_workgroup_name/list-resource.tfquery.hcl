@@ -0,0 +1,7 @@
+list "passwordsafe_asset_by_workgroup_name" "assets" {
+  provider = passwordsafe
+
+  config {
+    work_group_name = "workgroup1"
+  }
+}
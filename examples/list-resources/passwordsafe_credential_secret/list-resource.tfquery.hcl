@@ -0,0 +1,7 @@
+list "passwordsafe_credential_secret" "credentials" {
+  provider = passwordsafe
+
+  config {
+    folder_path = "folder1/folder2"
+  }
+}
@@ -0,0 +1,7 @@
+list "passwordsafe_database" "databases" {
+  provider = passwordsafe
+
+  config {
+    asset_id = 10
+  }
+}
@@ -0,0 +1,7 @@
+list "passwordsafe_file_secret" "files" {
+  provider = passwordsafe
+
+  config {
+    folder_path = "folder1"
+  }
+}
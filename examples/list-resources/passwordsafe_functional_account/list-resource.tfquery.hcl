@@ -0,0 +1,7 @@
+list "passwordsafe_functional_account" "service_accounts" {
+  provider = passwordsafe
+
+  config {
+    name_pattern = "svc_*"
+  }
+}
@@ -0,0 +1,8 @@
+list "passwordsafe_managed_account" "administrators" {
+  provider = passwordsafe
+
+  config {
+    system_name_pattern = "server*"
+    name_pattern        = "admin*"
+  }
+}
@@ -0,0 +1,8 @@
+list "passwordsafe_managed_system_by_asset" "servers" {
+  provider = passwordsafe
+
+  config {
+    workgroup_id = "1"
+    name_pattern = "server*"
+  }
+}
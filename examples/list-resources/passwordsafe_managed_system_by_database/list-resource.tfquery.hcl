@@ -0,0 +1,7 @@
+list "passwordsafe_managed_system_by_database" "databases" {
+  provider = passwordsafe
+
+  config {
+    platform_id = 11
+  }
+}
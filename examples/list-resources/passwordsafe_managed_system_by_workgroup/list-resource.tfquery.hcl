@@ -0,0 +1,7 @@
+list "passwordsafe_managed_system_by_workgroup" "directories" {
+  provider = passwordsafe
+
+  config {
+    platform_id = 25
+  }
+}
@@ -0,0 +1,8 @@
+list "passwordsafe_text_secret" "tokens" {
+  provider = passwordsafe
+
+  config {
+    folder_path  = "folder1"
+    name_pattern = "*token*"
+  }
+}
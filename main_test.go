@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+
+	providerFramework "terraform-provider-passwordsafe/providers/provider_framework"
+	providerSdkv2 "terraform-provider-passwordsafe/providers/provider_sdkv2"
+)
+
+// TestMuxServerSchema checks both providers can be served together using protocol version 5,
+// the provider schemas must be identical and every schema must be convertible to protocol 5.
+func TestMuxServerSchema(t *testing.T) {
+	ctx := context.Background()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol5(providerFramework.NewProvider()),
+		providerSdkv2.Provider().GRPCProvider,
+	)
+	if err != nil {
+		t.Fatalf("NewMuxServer: %v", err)
+	}
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+
+	for _, diagnostic := range resp.Diagnostics {
+		t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+
+	for _, name := range []string{"passwordsafe_rotate_managed_account", "passwordsafe_test_credentials", "passwordsafe_test_functional_account_credentials", "passwordsafe_terminate_requests"} {
+		if _, ok := resp.ActionSchemas[name]; !ok {
+			t.Errorf("Expected the %v action to be served", name)
+		}
+	}
+
+	identitySchemas, err := muxServer.ProviderServer().GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("GetResourceIdentitySchemas: %v", err)
+	}
+
+	for _, diagnostic := range identitySchemas.Diagnostics {
+		t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+
+	for _, name := range []string{
+		"passwordsafe_asset_by_workgroup_id", "passwordsafe_asset_by_workgroup_name", "passwordsafe_database",
+		"passwordsafe_managed_system_by_asset", "passwordsafe_managed_system_by_workgroup", "passwordsafe_managed_system_by_database",
+		"passwordsafe_functional_account", "passwordsafe_managed_account",
+		"passwordsafe_credential_secret", "passwordsafe_text_secret", "passwordsafe_file_secret",
+	} {
+		if _, ok := resp.ListResourceSchemas[name]; !ok {
+			t.Errorf("Expected the %v list resource to be served", name)
+		}
+		if _, ok := identitySchemas.IdentitySchemas[name]; !ok {
+			t.Errorf("Expected the %v resource to have an identity", name)
+		}
+	}
+}
+
+// TestMuxServerValidateProviderConfig checks the provider configuration is validated once, by the framework
+// provider, with the error reported on the attribute.
+func TestMuxServerValidateProviderConfig(t *testing.T) {
+	ctx := context.Background()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol5(providerFramework.NewProvider()),
+		providerSdkv2.Provider().GRPCProvider,
+	)
+	if err != nil {
+		t.Fatalf("NewMuxServer: %v", err)
+	}
+
+	schemaResp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "fake_api_key")
+	values["api_account_name"] = tftypes.NewValue(tftypes.String, "test-account")
+	values["client_id"] = tftypes.NewValue(tftypes.String, "test-client-id")
+	values["client_secret"] = tftypes.NewValue(tftypes.String, "test-client-secret")
+
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, values))
+	if err != nil {
+		t.Fatalf("NewDynamicValue: %v", err)
+	}
+
+	resp, err := muxServer.ProviderServer().PrepareProviderConfig(ctx, &tfprotov5.PrepareProviderConfigRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("PrepareProviderConfig: %v", err)
+	}
+
+	if len(resp.Diagnostics) != 1 || !resp.Diagnostics[0].Attribute.Equal(tftypes.NewAttributePath().WithAttributeName("api_key")) {
+		t.Errorf("Expected a single conflicting credentials error on api_key, got %+v", resp.Diagnostics)
+	}
+}
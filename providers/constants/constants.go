@@ -8,3 +8,38 @@ const (
 	FakeClientId     = "faked050-e266-4b05-9ced-35e7dd5093ae"
 	FakeClientSecret = "fake3BMkkxe4OpdsJPMhmYTRSpeHJYA/NVmcnmPZv5s="
 )
+
+// environment variables used when a provider attribute is not set in the provider block.
+const (
+	EnvAPIKey                    = "PS_API_KEY"
+	EnvClientID                  = "PS_CLIENT_ID"
+	EnvClientSecret              = "PS_CLIENT_SECRET"
+	EnvURL                       = "PS_URL"
+	EnvAPIVersion                = "PS_API_VERSION"
+	EnvAPIAccountName            = "PS_ACCOUNT_NAME"
+	EnvVerifyCA                  = "PS_VERIFY_CA"
+	EnvClientCertificatesPath    = "PS_CERTIFICATE_PATH"
+	EnvClientCertificateName     = "PS_CERTIFICATE_NAME"
+	EnvClientCertificatePassword = "PS_CERTIFICATE_PASSWORD"
+	EnvClientCertificatePEM      = "PS_CLIENT_CERTIFICATE_PEM"
+	EnvClientKeyPEM              = "PS_CLIENT_KEY_PEM"
+	EnvClientCertificateFile     = "PS_CLIENT_CERTIFICATE_FILE"
+	EnvClientKeyFile             = "PS_CLIENT_KEY_FILE"
+	EnvCACertFile                = "PS_CA_CERT_FILE"
+	EnvCACertPEM                 = "PS_CA_CERT_PEM"
+	EnvTLSServerName             = "PS_TLS_SERVER_NAME"
+	EnvProxyURL                  = "PS_PROXY_URL"
+	EnvProxyUsername             = "PS_PROXY_USERNAME"
+	EnvProxyPassword             = "PS_PROXY_PASSWORD"
+	EnvNoProxy                   = "PS_NO_PROXY"
+	EnvCredentialCache           = "PS_CREDENTIAL_CACHE"
+	EnvCredentialCacheTTL        = "PS_CREDENTIAL_CACHE_TTL"
+	EnvRequestTimeout            = "PS_REQUEST_TIMEOUT"
+	EnvMaxRetries                = "PS_MAX_RETRIES"
+	EnvRetryMaxElapsed           = "PS_RETRY_MAX_ELAPSED"
+	EnvRetryInitialInterval      = "PS_RETRY_INITIAL_INTERVAL"
+	EnvCredentialsCommand        = "PS_CREDENTIALS_COMMAND"
+	EnvCredentialsCommandTimeout = "PS_CREDENTIALS_COMMAND_TIMEOUT"
+	EnvLogLevel                  = "PS_LOG_LEVEL"
+	EnvLogFile                   = "PS_LOG_FILE"
+)
@@ -14,3 +14,129 @@ type PasswordSafeTestConfig struct {
 	APIVersion                   string
 	Resource                     string
 }
+
+// AccessRequest responsible for Requests endpoint response data.
+type AccessRequest struct {
+	RequestID        int
+	SystemID         int
+	AccountID        int
+	Status           string
+	Reason           string
+	ApproverComments string
+}
+
+// Alias responsible for Aliases endpoint response data.
+type Alias struct {
+	AliasId        int
+	AliasName      string
+	AliasState     int
+	SystemId       int
+	SystemName     string
+	AccountId      int
+	AccountName    string
+	DomainName     string
+	InstanceName   string
+	MappedAccounts []AliasMappedAccount
+}
+
+// AliasAccessRequest responsible for the body of an alias access request.
+type AliasAccessRequest struct {
+	AccessType      string
+	DurationMinutes int
+	Reason          string
+	ConflictOption  string
+}
+
+// AliasMappedAccount responsible for managed accounts mapped to an alias.
+type AliasMappedAccount struct {
+	AliasID          int
+	ManagedSystemID  int
+	ManagedAccountID int
+	Status           string
+}
+
+// ManagedAccountDetails responsible for ManagedAccounts/{id} endpoint response data.
+type ManagedAccountDetails struct {
+	ManagedAccountID int
+	ManagedSystemID  int
+	AccountName      string
+	DomainName       string
+	IsChanging       bool
+	ChangeState      int
+	LastChangeDate   string
+}
+
+// SecretVersion responsible for secrets-safe/secrets/{id}/versions endpoint response data.
+type SecretVersion struct {
+	Version    int
+	ModifiedOn string
+	ModifiedBy string
+	SecretType string `json:",omitempty"`
+	Password   string `json:",omitempty"`
+	Text       string `json:",omitempty"`
+}
+
+// PasswordRule responsible for PasswordRules/{id} endpoint response data. Requirements are (N)ot allowed,
+// (P)ermitted or (R)equired, the first character requirement is (C)haracters, (N)umerals or (A)ny.
+type PasswordRule struct {
+	PasswordRuleID             int
+	Name                       string
+	MinimumLength              int
+	MaximumLength              int
+	FirstCharacterRequirement  string
+	LowercaseRequirement       string
+	UppercaseRequirement       string
+	NumericRequirement         string
+	SymbolRequirement          string
+	MinimumLowercaseCharacters int
+	MinimumUppercaseCharacters int
+	MinimumNumericCharacters   int
+	MinimumSymbols             int
+	ValidLowercaseCharacters   []string
+	ValidUppercaseCharacters   []string
+	ValidSymbols               []string
+}
+
+// CredentialTestResult responsible for ManagedAccounts/{id}/Credentials/Test and FunctionalAccounts/{id}/Credentials/Test endpoints response data.
+type CredentialTestResult struct {
+	Success bool
+}
+
+// SecretSummary responsible for secrets-safe/secrets endpoint list response data, without the secret values.
+type SecretSummary struct {
+	Id          string
+	Title       string
+	Description string
+	Username    string
+	SecretType  string
+	FolderId    string
+	Folder      string
+	FolderPath  string
+	OwnerId     int
+	OwnerType   string
+	Notes       string
+}
+
+// SecretOwner responsible for the owners of secrets-safe/secrets endpoint response data.
+type SecretOwner struct {
+	OwnerId int
+	Owner   string
+	Email   string
+}
+
+// SecretUrl responsible for the URLs of secrets-safe/secrets endpoint response data.
+type SecretUrl struct {
+	Id  string
+	Url string
+}
+
+// SecretMetadata responsible for secrets-safe/secrets endpoint response data, without the secret values.
+type SecretMetadata struct {
+	SecretSummary
+	Owners     []SecretOwner
+	Urls       []SecretUrl
+	CreatedOn  string
+	CreatedBy  string
+	ModifiedOn string
+	ModifiedBy string
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AliasDataSource{}
+
+func NewAliasDataSource() datasource.DataSource {
+	return &AliasDataSource{}
+}
+
+type AliasDataSource struct {
+	providerInfo *ProviderData
+}
+
+func (d *AliasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias_datasource"
+}
+
+type AliasDataSourceModel struct {
+	AliasName   types.String `tfsdk:"alias_name"`
+	AliasID     types.Int32  `tfsdk:"alias_id"`
+	AliasState  types.Int32  `tfsdk:"alias_state"`
+	SystemID    types.Int32  `tfsdk:"system_id"`
+	SystemName  types.String `tfsdk:"system_name"`
+	AccountID   types.Int32  `tfsdk:"account_id"`
+	AccountName types.String `tfsdk:"account_name"`
+	DomainName  types.String `tfsdk:"domain_name"`
+}
+
+func (d *AliasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Alias Datasource, resolves an alias to the managed account it currently points to.",
+		Attributes: map[string]schema.Attribute{
+			"alias_name": schema.StringAttribute{
+				MarkdownDescription: "Alias Name",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"alias_id": schema.Int32Attribute{
+				MarkdownDescription: "Alias ID",
+				Computed:            true,
+			},
+			"alias_state": schema.Int32Attribute{
+				MarkdownDescription: "Alias State (0: Unmapped, 1: Mapped, 2: Highly Available)",
+				Computed:            true,
+			},
+			"system_id": schema.Int32Attribute{
+				MarkdownDescription: "Managed System ID of the active managed account",
+				Computed:            true,
+			},
+			"system_name": schema.StringAttribute{
+				MarkdownDescription: "Managed System Name of the active managed account",
+				Computed:            true,
+			},
+			"account_id": schema.Int32Attribute{
+				MarkdownDescription: "Managed Account ID of the active managed account",
+				Computed:            true,
+			},
+			"account_name": schema.StringAttribute{
+				MarkdownDescription: "Managed Account Name of the active managed account",
+				Computed:            true,
+			},
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "Domain Name of the active managed account",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AliasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	d.providerInfo = &c
+
+	if d.providerInfo.userName == "" {
+		return
+	}
+
+}
+
+func (d *AliasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AliasDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// get alias by name.
+	alias, err := utils.GetAliasByName(*d.providerInfo.authenticationObj, data.AliasName.ValueString(), zapLogger)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting alias", err.Error())
+		return
+	}
+
+	data.AliasID = types.Int32Value(int32(alias.AliasId))
+	data.AliasState = types.Int32Value(int32(alias.AliasState))
+	data.SystemID = types.Int32Value(int32(alias.SystemId))
+	data.SystemName = types.StringValue(alias.SystemName)
+	data.AccountID = types.Int32Value(int32(alias.AccountId))
+	data.AccountName = types.StringValue(alias.AccountName)
+	data.DomainName = types.StringValue(alias.DomainName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+}
@@ -0,0 +1,185 @@
+package provider_framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var aliasConfig = entities.PasswordSafeTestConfig{
+	APIKey:       "",
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+		data "passwordsafe_alias_datasource" "alias" {
+			alias_name = "db_admin"
+		}`,
+}
+
+func TestGetAlias(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, err := w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, err := w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Aliases":
+			_, err := w.Write([]byte(`{"AliasId": 3, "AliasName": "db_admin", "AliasState": 2, "SystemId": 5, "SystemName": "db01", "AccountId": 12, "AccountName": "admin_b", "DomainName": "example.com"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/Signout":
+			_, err := w.Write([]byte(``))
+			if err != nil {
+				t.Error(err.Error())
+			}
+		}
+
+	}))
+
+	server.URL = server.URL + constants.APIPath
+
+	aliasConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// test using oauth authentication, get alias
+				Config: utils.TestResourceConfig(aliasConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_alias_datasource.alias",
+						tfjsonpath.New("account_name"),
+						knownvalue.StringExact("admin_b"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_alias_datasource.alias",
+						tfjsonpath.New("alias_state"),
+						knownvalue.Int32Exact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestGetAliasNotFound(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, err := w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, err := w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Aliases":
+			// not found mock
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`"Alias not found"`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/Signout":
+			_, err := w.Write([]byte(``))
+			if err != nil {
+				t.Error(err.Error())
+			}
+		}
+
+	}))
+
+	server.URL = server.URL + constants.APIPath
+
+	aliasConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      utils.TestResourceConfig(aliasConfig),
+				ExpectError: regexp.MustCompile("Error getting alias"),
+			},
+		},
+	})
+}
+
+// Unit tests for alias datasource methods
+func TestAliasDataSourceMetadata(t *testing.T) {
+	ds := NewAliasDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "passwordsafe",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "passwordsafe_alias_datasource" {
+		t.Errorf("Expected TypeName 'passwordsafe_alias_datasource', got '%s'", resp.TypeName)
+	}
+}
+
+func TestAliasDataSourceSchema(t *testing.T) {
+	ds := NewAliasDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	if !resp.Schema.Attributes["alias_name"].IsRequired() {
+		t.Error("alias_name attribute should be required")
+	}
+}
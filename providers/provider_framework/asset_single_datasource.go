@@ -0,0 +1,83 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &AssetSingleDataSource{}
+
+// assetFilters are the attributes an asset of a workgroup is looked up by.
+var assetFilters = []string{"asset_id", "asset_name", "ip_address", "dns_name"}
+
+func NewAssetSingleDataSource() datasource.DataSource {
+	return &AssetSingleDataSource{}
+}
+
+type AssetSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *AssetSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset"
+}
+
+func (d *AssetSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := singleDataSourceAttributes(getAssetDataSourceSchemaAttributes(), assetFilters)
+
+	// assets are listed by workgroup
+	attributes["workgroup_id"] = schema.Int32Attribute{
+		MarkdownDescription: "Workgroup ID",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Asset Datasource, gets an asset of a workgroup by ID, name, IP address or DNS name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *AssetSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(assetFilters)
+}
+
+func (d *AssetSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// get assets list using workgroup id.
+	items, err := utils.GetAssetsList(*d.providerInfo.authenticationObj, strconv.Itoa(int(data.WorkgroupID.ValueInt32())), 0, zapLogger)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting assets list by workgroup id", err.Error())
+		return
+	}
+
+	item, diags := findSingle("asset", items,
+		int32Filter("asset_id", data.AssetID, func(item entities.AssetResponse) int { return item.AssetID }),
+		stringFilter("asset_name", data.AssetName, func(item entities.AssetResponse) string { return item.AssetName }),
+		stringFilter("ip_address", data.IPAddress, func(item entities.AssetResponse) string { return item.IPAddress }),
+		stringFilter("dns_name", data.DnsName, func(item entities.AssetResponse) string { return item.DnsName }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAssetModel(item))...)
+
+}
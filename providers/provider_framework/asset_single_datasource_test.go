@@ -0,0 +1,101 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var assetSingleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_asset" "asset" {
+		workgroup_id = 1
+		ip_address = "10.0.0.1"
+	}`,
+}
+
+var assetSingleMultipleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_asset" "asset" {
+		workgroup_id = 1
+		dns_name = "web.example.com"
+	}`,
+}
+
+func TestGetAsset(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/workgroups/1/assets":
+			response = `[ { "WorkgroupID": 1, "AssetID": 36, "AssetName": "web01", "DnsName": "web.example.com", "IPAddress": "10.0.0.1" }, { "WorkgroupID": 1, "AssetID": 37, "AssetName": "web02", "DnsName": "web.example.com", "IPAddress": "10.0.0.2" } ]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	assetSingleConfig.URL = server.URL
+	assetSingleMultipleConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				// test getting the only object matching the filters
+				Config: utils.TestResourceConfig(assetSingleConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_asset.asset",
+						tfjsonpath.New("asset_name"),
+						knownvalue.StringExact("web01"),
+					),
+				},
+			},
+			{
+				// test filters matching more than one object
+				Config:      utils.TestResourceConfig(assetSingleMultipleConfig),
+				ExpectError: regexp.MustCompile("Multiple assets found"),
+			},
+		},
+	})
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/BeyondTrust/go-client-library-passwordsafe/api/assets"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 )
 
 var _ datasource.DataSource = &AssetDataSource{}
@@ -26,6 +26,7 @@ type AssetDataSource struct {
 type AssetDataSourceModel struct {
 	Assets    []AssetModel `tfsdk:"assets"`
 	Parameter types.String `tfsdk:"parameter"`
+	Limit     types.Int32  `tfsdk:"limit"`
 }
 
 func (d *AssetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -56,12 +57,13 @@ func (d *AssetDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Parameter",
 				Required:            true,
 			},
+			"limit": limitFilterAttribute("assets"),
 		},
 		Blocks: map[string]schema.Block{
 			"assets": schema.ListNestedBlock{
 				Description: "Asset Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: d.getAssetDataSourceSchemaAttributes(),
+					Attributes: getAssetDataSourceSchemaAttributes(),
 				},
 			},
 		},
@@ -69,7 +71,7 @@ func (d *AssetDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 }
 
 // getAssetDataSourceSchemaAttributes get schema attributes.
-func (d *AssetDataSource) getAssetDataSourceSchemaAttributes() map[string]schema.Attribute {
+func getAssetDataSourceSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"workgroup_id":     utils.GetInt32Attribute("Workgroup ID", false, false, true),
 		"asset_id":         utils.GetInt32Attribute("Asset ID", false, false, true),
@@ -109,51 +111,42 @@ func (d *AssetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// instantiating asset obj
-	asssetObj, _ := assets.NewAssetObj(*d.providerInfo.authenticationObj, zapLogger)
+	limit := int(inputData.Limit.ValueInt32())
 
-	// get assets list using workgroup id.
-	itemsByWorkgroupId, err := asssetObj.GetAssetsListByWorkgroupIdFlow(inputData.Parameter.ValueString())
+	// get assets list of the workgroup, the API accepts a workgroup id or name.
+	items, err := utils.GetAssetsList(*d.providerInfo.authenticationObj, inputData.Parameter.ValueString(), limit, zapLogger)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error getting assets list by workgroup id", err.Error())
+		resp.Diagnostics.AddError("Error getting assets list", err.Error())
 		return
 	}
 
-	items := itemsByWorkgroupId
-
-	// if there is not assets by workgroup id so it will search by workgroup name.
-	if len(items) == 0 {
-		// get assets list using workgroup name.
-		itemsByWorkgroupName, err := asssetObj.GetAssetsListByWorkgroupNameFlow(inputData.Parameter.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error getting assets list by workgroup name", err.Error())
-			return
-		}
-		items = itemsByWorkgroupName
-	}
-
 	var assetsList []AssetModel
 
 	for _, item := range items {
-		assetsList = append(assetsList, AssetModel{
-			WorkgroupID:     types.Int32Value(int32(item.WorkgroupID)),
-			AssetID:         types.Int32Value(int32(item.AssetID)),
-			AssetName:       types.StringValue(item.AssetName),
-			AssetType:       types.StringValue(item.AssetType),
-			DnsName:         types.StringValue(item.DnsName),
-			DomainName:      types.StringValue(item.DomainName),
-			IPAddress:       types.StringValue(item.IPAddress),
-			OperatingSystem: types.StringValue(item.OperatingSystem),
-			CreateDate:      types.StringValue(item.CreateDate),
-			LastUpdateDate:  types.StringValue(item.LastUpdateDate),
-			Description:     types.StringValue(item.Description),
-		})
+		assetsList = append(assetsList, newAssetModel(item))
 	}
 
-	responseData := AssetDataSourceModel{}
+	responseData := inputData
 	responseData.Assets = assetsList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newAssetModel returns the datasource attributes of a asset.
+func newAssetModel(item entities.AssetResponse) AssetModel {
+	return AssetModel{
+		WorkgroupID:     types.Int32Value(int32(item.WorkgroupID)),
+		AssetID:         types.Int32Value(int32(item.AssetID)),
+		AssetName:       types.StringValue(item.AssetName),
+		AssetType:       types.StringValue(item.AssetType),
+		DnsName:         types.StringValue(item.DnsName),
+		DomainName:      types.StringValue(item.DomainName),
+		IPAddress:       types.StringValue(item.IPAddress),
+		OperatingSystem: types.StringValue(item.OperatingSystem),
+		CreateDate:      types.StringValue(item.CreateDate),
+		LastUpdateDate:  types.StringValue(item.LastUpdateDate),
+		Description:     types.StringValue(item.Description),
+	}
+}
@@ -169,7 +169,7 @@ func TestGetAssetsListNotFound(t *testing.T) {
 			{
 				// test using oauth authentication
 				Config:      utils.TestResourceConfig(assetsListConfig),
-				ExpectError: regexp.MustCompile("Error getting assets list by workgroup id"),
+				ExpectError: regexp.MustCompile("Error getting assets list"),
 			},
 		},
 	})
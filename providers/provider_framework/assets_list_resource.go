@@ -0,0 +1,180 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/assets"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &assetByWorkGroupIdListResource{}
+var _ list.ListResourceWithConfigure = &assetByWorkGroupNameListResource{}
+
+func NewAssetByWorkGroupIdListResource() list.ListResource {
+	return &assetByWorkGroupIdListResource{}
+}
+
+func NewAssetByWorkGroupNameListResource() list.ListResource {
+	return &assetByWorkGroupNameListResource{}
+}
+
+type assetByWorkGroupIdListResource struct {
+	listResource
+}
+
+type assetByWorkGroupNameListResource struct {
+	listResource
+}
+
+type AssetByWorkGroupIdListResourceModel struct {
+	WorkGroupId types.String `tfsdk:"work_group_id"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+type AssetByWorkGroupNameListResourceModel struct {
+	WorkGroupName types.String `tfsdk:"work_group_name"`
+	NamePattern   types.String `tfsdk:"name_pattern"`
+}
+
+func (l *assetByWorkGroupIdListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_by_workgroup_id"
+}
+
+func (l *assetByWorkGroupIdListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Asset list resource, lists the assets of a workgroup by workgroup id.",
+		Attributes: map[string]schema.Attribute{
+			"work_group_id": schema.StringAttribute{
+				Description: "Workgroup Id",
+				Required:    true,
+			},
+			"name_pattern": namePatternAttribute("Asset name pattern."),
+		},
+	}
+}
+
+func (l *assetByWorkGroupIdListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AssetByWorkGroupIdListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	assetObj, err := assets.NewAssetObj(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating asset object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := assetObj.GetAssetsListByWorkgroupIdFlow(data.WorkGroupId.ValueString())
+	if err != nil {
+		diags.AddError("Error getting assets list by workgroup id", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	streamListResults(ctx, req, stream, filterAssets(items, data.NamePattern), func(item entities.AssetResponse, result *list.ListResult) {
+		setAssetListResult(ctx, req, item, result)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, AssetResorceByWorkGroupIdModel{
+				AssetResorceModel: assetResourceModel(item),
+				WorkGroupId:       data.WorkGroupId,
+			})...)
+		}
+	})
+}
+
+func (l *assetByWorkGroupNameListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_by_workgroup_name"
+}
+
+func (l *assetByWorkGroupNameListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Asset list resource, lists the assets of a workgroup by workgroup name.",
+		Attributes: map[string]schema.Attribute{
+			"work_group_name": schema.StringAttribute{
+				Description: "Workgroup Name",
+				Required:    true,
+			},
+			"name_pattern": namePatternAttribute("Asset name pattern."),
+		},
+	}
+}
+
+func (l *assetByWorkGroupNameListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AssetByWorkGroupNameListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	assetObj, err := assets.NewAssetObj(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating asset object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := assetObj.GetAssetsListByWorkgroupNameFlow(data.WorkGroupName.ValueString())
+	if err != nil {
+		diags.AddError("Error getting assets list by workgroup name", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	streamListResults(ctx, req, stream, filterAssets(items, data.NamePattern), func(item entities.AssetResponse, result *list.ListResult) {
+		setAssetListResult(ctx, req, item, result)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, AssetResorceByWorkGroupNameModel{
+				AssetResorceModel: assetResourceModel(item),
+				WorkGroupName:     data.WorkGroupName,
+			})...)
+		}
+	})
+}
+
+// filterAssets returns the assets whose name matches namePattern.
+func filterAssets(items []entities.AssetResponse, namePattern types.String) []entities.AssetResponse {
+	pattern := utils.NewNamePattern(namePattern.ValueString())
+
+	var filtered []entities.AssetResponse
+	for _, item := range items {
+		if pattern.Match(item.AssetName) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// setAssetListResult sets the display name and the identity of the list result of an asset.
+func setAssetListResult(ctx context.Context, req list.ListRequest, item entities.AssetResponse, result *list.ListResult) {
+	result.DisplayName = item.AssetName
+	result.Diagnostics.Append(setInt32Identity(ctx, result.Identity, "asset_id", types.Int32Value(int32(item.AssetID)))...)
+}
+
+// assetResourceModel returns the resource attributes of an asset.
+func assetResourceModel(item entities.AssetResponse) AssetResorceModel {
+	return AssetResorceModel{
+		AssetID:         types.Int32Value(int32(item.AssetID)),
+		IPAddress:       types.StringValue(item.IPAddress),
+		AssetName:       types.StringValue(item.AssetName),
+		DnsName:         types.StringValue(item.DnsName),
+		DomainName:      types.StringValue(item.DomainName),
+		AssetType:       types.StringValue(item.AssetType),
+		Description:     types.StringValue(item.Description),
+		OperatingSystem: types.StringValue(item.OperatingSystem),
+	}
+}
@@ -0,0 +1,92 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var AssetListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_asset_by_workgroup_id" "web" {
+	provider = passwordsafe
+	config {
+		work_group_id = "1"
+		name_pattern = "web*"
+	}
+	}
+
+	list "passwordsafe_asset_by_workgroup_name" "all" {
+	provider = passwordsafe
+	config {
+		work_group_name = "workgroup1"
+	}
+	}`,
+}
+
+func TestAssetListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/workgroups/1/assets", constants.APIPath + "/workgroups/workgroup1/assets":
+			response = `[{"WorkgroupID": 1, "AssetID": 36, "AssetName": "web01", "IPAddress": "10.0.0.1"},{"WorkgroupID": 1, "AssetID": 37, "AssetName": "WEB02", "IPAddress": "10.0.0.2"},{"WorkgroupID": 1, "AssetID": 38, "AssetName": "db01", "IPAddress": "10.0.0.3"}]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	AssetListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(AssetListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_asset_by_workgroup_id.web", 2),
+					querycheck.ExpectIdentity("passwordsafe_asset_by_workgroup_id.web", map[string]knownvalue.Check{
+						"asset_id": knownvalue.Int32Exact(37),
+					}),
+					querycheck.ExpectLength("passwordsafe_asset_by_workgroup_name.all", 3),
+				},
+			},
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/assets"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -54,7 +53,8 @@ func (r *assetResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *assetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "asset_id", req, resp)
 }
 
 func (r *assetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -82,14 +82,19 @@ func (r *assetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *assetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("asset_id", "Asset Id")
+}
+
 func (r *assetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "asset_id", req, resp)
 }
 
 // NewAssetByWorkgGroypIdResource
 
 var _ resource.Resource = &assetResourceByWorkGroupId{}
 var _ resource.ResourceWithImportState = &assetResourceByWorkGroupId{}
+var _ resource.ResourceWithIdentity = &assetResourceByWorkGroupId{}
 
 type AssetResorceByWorkGroupIdModel struct {
 	AssetResorceModel
@@ -194,6 +199,7 @@ func (r *assetResourceByWorkGroupId) Create(ctx context.Context, req resource.Cr
 	data.AssetID = types.Int32Value(int32(createdAsset.AssetID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "asset_id", data.AssetID)...)
 }
 
 func (r *assetResourceByWorkGroupId) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -221,6 +227,7 @@ func (r *assetResourceByWorkGroupId) Delete(ctx context.Context, req resource.De
 
 var _ resource.Resource = &assetResourceByWorkGroupName{}
 var _ resource.ResourceWithImportState = &assetResourceByWorkGroupName{}
+var _ resource.ResourceWithIdentity = &assetResourceByWorkGroupName{}
 
 type AssetResorceByWorkGroupNameModel struct {
 	AssetResorceModel
@@ -317,6 +324,7 @@ func (r *assetResourceByWorkGroupName) Create(ctx context.Context, req resource.
 	data.AssetID = types.Int32Value(int32(createdAsset.AssetID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "asset_id", data.AssetID)...)
 }
 
 func (r *assetResourceByWorkGroupName) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
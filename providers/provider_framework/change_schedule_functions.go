@@ -0,0 +1,148 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// changeScheduleParameters are the change_frequency_type, change_frequency_days and change_time parameters, null
+// values use the Password Safe defaults.
+var changeScheduleParameters = []function.Parameter{
+	function.StringParameter{
+		Name:           "change_frequency_type",
+		Description:    "Change frequency type, one of first, last or xdays (default: first).",
+		AllowNullValue: true,
+	},
+	function.Int64Parameter{
+		Name:           "change_frequency_days",
+		Description:    "Days between changes when change_frequency_type is xdays, between 1 and 999.",
+		AllowNullValue: true,
+	},
+	function.StringParameter{
+		Name:           "change_time",
+		Description:    "Change time, 24 hour HH:MM (default: 23:30).",
+		AllowNullValue: true,
+	},
+}
+
+// changeSchedule holds the change schedule arguments.
+type changeSchedule struct {
+	changeFrequencyType types.String
+	changeFrequencyDays types.Int64
+	changeTime          types.String
+}
+
+// values returns the schedule with the defaults applied to null arguments.
+func (schedule changeSchedule) values() (string, int, string) {
+	changeFrequencyType := schedule.changeFrequencyType.ValueString()
+	if schedule.changeFrequencyType.IsNull() {
+		changeFrequencyType = utils.DefaultChangeFrequencyType
+	}
+
+	changeTime := schedule.changeTime.ValueString()
+	if schedule.changeTime.IsNull() {
+		changeTime = utils.DefaultChangeTime
+	}
+	return changeFrequencyType, int(schedule.changeFrequencyDays.ValueInt64()), changeTime
+}
+
+// NextChangeDateFunction returns the date of the next scheduled password change.
+type NextChangeDateFunction struct{}
+
+// ValidateChangeScheduleFunction validates a change schedule.
+type ValidateChangeScheduleFunction struct{}
+
+var (
+	_ function.Function = &NextChangeDateFunction{}
+	_ function.Function = &ValidateChangeScheduleFunction{}
+)
+
+// NewNextChangeDateFunction returns the next_change_date function.
+func NewNextChangeDateFunction() function.Function {
+	return &NextChangeDateFunction{}
+}
+
+// NewValidateChangeScheduleFunction returns the validate_change_schedule function.
+func NewValidateChangeScheduleFunction() function.Function {
+	return &ValidateChangeScheduleFunction{}
+}
+
+func (f *NextChangeDateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_change_date"
+}
+
+func (f *NextChangeDateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the date of the next scheduled password change.",
+		MarkdownDescription: "Returns the RFC 3339 timestamp of the first password change scheduled after last_change. first and last changes happen on the first " +
+			"or last day of the month at change_time, xdays changes happen change_frequency_days days after last_change at change_time. The timestamp is in the time zone of last_change.",
+		Parameters: append(slices.Clone(changeScheduleParameters), function.StringParameter{
+			Name:        "last_change",
+			Description: "Date of the last password change, an RFC 3339 timestamp or a YYYY-MM-DD date in UTC.",
+		}),
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NextChangeDateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule changeSchedule
+	var lastChangeValue string
+
+	resp.Error = req.Arguments.Get(ctx, &schedule.changeFrequencyType, &schedule.changeFrequencyDays, &schedule.changeTime, &lastChangeValue)
+	if resp.Error != nil {
+		return
+	}
+
+	lastChange, err := utils.ParseChangeDate(lastChangeValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+
+	changeFrequencyType, changeFrequencyDays, changeTime := schedule.values()
+	nextChange, err := utils.NextChangeDate(changeFrequencyType, changeFrequencyDays, changeTime, lastChange)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, nextChange.Format(time.RFC3339))
+}
+
+func (f *ValidateChangeScheduleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_change_schedule"
+}
+
+func (f *ValidateChangeScheduleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validates a password change schedule.",
+		MarkdownDescription: "Returns true when the change schedule is valid: change_frequency_type is first, last or xdays, change_frequency_days is between 1 and 999 " +
+			"when change_frequency_type is xdays, and change_time is a 24 hour HH:MM time. Otherwise fails with the reason, so it can be used in variable validation conditions.",
+		Parameters: changeScheduleParameters,
+		Return:     function.BoolReturn{},
+	}
+}
+
+func (f *ValidateChangeScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule changeSchedule
+
+	resp.Error = req.Arguments.Get(ctx, &schedule.changeFrequencyType, &schedule.changeFrequencyDays, &schedule.changeTime)
+	if resp.Error != nil {
+		return
+	}
+
+	if err := utils.ValidateChangeSchedule(schedule.values()); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, true)
+}
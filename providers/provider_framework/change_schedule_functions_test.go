@@ -0,0 +1,54 @@
+package provider_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runChangeScheduleFunction runs a change schedule function with the given arguments, the result is a string or a bool.
+func runChangeScheduleFunction(t *testing.T, f function.Function, arguments ...attr.Value) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	var definition function.DefinitionResponse
+	f.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+	if _, ok := definition.Definition.Return.(function.BoolReturn); ok {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+	}
+
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+	return resp
+}
+
+func TestNextChangeDateFunction(t *testing.T) {
+	resp := runChangeScheduleFunction(t, NewNextChangeDateFunction(), types.StringValue("xdays"), types.Int64Value(30), types.StringNull(), types.StringValue("2025-01-31"))
+	if resp.Error != nil || !resp.Result.Value().Equal(types.StringValue("2025-03-02T23:30:00Z")) {
+		t.Errorf("next_change_date = %v, %v", resp.Result.Value(), resp.Error)
+	}
+
+	resp = runChangeScheduleFunction(t, NewNextChangeDateFunction(), types.StringNull(), types.Int64Null(), types.StringValue("02:00"), types.StringValue("2025-01-31T10:00:00+01:00"))
+	if resp.Error != nil || !resp.Result.Value().Equal(types.StringValue("2025-02-01T02:00:00+01:00")) {
+		t.Errorf("next_change_date = %v, %v", resp.Result.Value(), resp.Error)
+	}
+
+	resp = runChangeScheduleFunction(t, NewNextChangeDateFunction(), types.StringNull(), types.Int64Null(), types.StringNull(), types.StringValue("yesterday"))
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 3 {
+		t.Errorf("expected an error on last_change, got %v", resp.Error)
+	}
+}
+
+func TestValidateChangeScheduleFunction(t *testing.T) {
+	resp := runChangeScheduleFunction(t, NewValidateChangeScheduleFunction(), types.StringValue("last"), types.Int64Null(), types.StringValue("00:15"))
+	if resp.Error != nil || !resp.Result.Value().Equal(types.BoolValue(true)) {
+		t.Errorf("validate_change_schedule = %v, %v", resp.Result.Value(), resp.Error)
+	}
+
+	resp = runChangeScheduleFunction(t, NewValidateChangeScheduleFunction(), types.StringValue("xdays"), types.Int64Value(0), types.StringValue("25:00"))
+	if resp.Error == nil {
+		t.Error("expected an error for an invalid change schedule")
+	}
+}
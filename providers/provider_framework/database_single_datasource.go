@@ -0,0 +1,77 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/databases"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &DatabaseSingleDataSource{}
+
+// databaseFilters are the attributes a database is looked up by.
+var databaseFilters = []string{"database_id", "asset_id", "instance_name", "platform_id"}
+
+func NewDatabaseSingleDataSource() datasource.DataSource {
+	return &DatabaseSingleDataSource{}
+}
+
+type DatabaseSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *DatabaseSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *DatabaseSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Database Datasource, gets a database by ID, asset, instance name or platform.",
+		Attributes:  singleDataSourceAttributes(getDatabaseDataSourceSchemaAttributes(), databaseFilters),
+	}
+}
+
+func (d *DatabaseSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(databaseFilters)
+}
+
+func (d *DatabaseSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabaseModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// instantiating database obj
+	databaseObj, _ := databases.NewDatabaseObj(*d.providerInfo.authenticationObj, zapLogger)
+
+	// get databases list
+	items, err := databaseObj.GetDatabasesListFlow()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting databases list", err.Error())
+		return
+	}
+
+	item, diags := findSingle("database", items,
+		int32Filter("database_id", data.DatabaseID, func(item entities.DatabaseResponse) int { return item.DatabaseID }),
+		int32Filter("asset_id", data.AssetID, func(item entities.DatabaseResponse) int { return item.AssetID }),
+		stringFilter("instance_name", data.InstanceName, func(item entities.DatabaseResponse) string { return item.InstanceName }),
+		int32Filter("platform_id", data.PlatformID, func(item entities.DatabaseResponse) int { return item.PlatformID }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newDatabaseModel(item))...)
+
+}
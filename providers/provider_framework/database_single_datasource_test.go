@@ -0,0 +1,100 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var databaseSingleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_database" "database" {
+		asset_id = 1
+		instance_name = "primary"
+	}`,
+}
+
+var databaseSingleMultipleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_database" "database" {
+		asset_id = 1
+	}`,
+}
+
+func TestGetDatabase(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/Databases":
+			response = `[ { "AssetID": 1, "DatabaseID": 5, "PlatformID": 11, "InstanceName": "primary" }, { "AssetID": 1, "DatabaseID": 6, "PlatformID": 11, "InstanceName": "replica" } ]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	databaseSingleConfig.URL = server.URL
+	databaseSingleMultipleConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				// test getting the only object matching the filters
+				Config: utils.TestResourceConfig(databaseSingleConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_database.database",
+						tfjsonpath.New("database_id"),
+						knownvalue.Int32Exact(5),
+					),
+				},
+			},
+			{
+				// test filters matching more than one object
+				Config:      utils.TestResourceConfig(databaseSingleMultipleConfig),
+				ExpectError: regexp.MustCompile("Multiple databases found"),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-passwordsafe/providers/utils"
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/databases"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,17 +40,25 @@ type DatabaseModel struct {
 }
 
 type DatabaseDataSourceModel struct {
-	Databases []DatabaseModel `tfsdk:"databases"`
+	Databases  []DatabaseModel `tfsdk:"databases"`
+	AssetID    types.Int32     `tfsdk:"asset_id"`
+	PlatformID types.Int32     `tfsdk:"platform_id"`
+	Limit      types.Int32     `tfsdk:"limit"`
 }
 
 func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Database Datasource, get databases list.",
+		Attributes: map[string]schema.Attribute{
+			"asset_id":    idFilterAttribute("Returns only the databases of the asset."),
+			"platform_id": idFilterAttribute("Returns only the databases of the platform."),
+			"limit":       limitFilterAttribute("databases"),
+		},
 		Blocks: map[string]schema.Block{
 			"databases": schema.ListNestedBlock{
 				Description: "Database Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: d.getDatabaseDataSourceSchemaAttributes(),
+					Attributes: getDatabaseDataSourceSchemaAttributes(),
 				},
 			},
 		},
@@ -57,7 +66,7 @@ func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 }
 
 // getDatabaseDataSourceSchemaAttributes get schema attributes.
-func (d *DatabaseDataSource) getDatabaseDataSourceSchemaAttributes() map[string]schema.Attribute {
+func getDatabaseDataSourceSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"asset_id":            utils.GetInt32Attribute("Asset ID", false, false, true),
 		"database_id":         utils.GetInt32Attribute("Database ID", false, false, true),
@@ -105,22 +114,37 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	var databasesList []DatabaseModel
 
-	for _, items := range items {
-		databasesList = append(databasesList, DatabaseModel{
-			AssetID:           types.Int32Value(int32(items.AssetID)),
-			DatabaseID:        types.Int32Value(int32(items.DatabaseID)),
-			PlatformID:        types.Int32Value(int32(items.PlatformID)),
-			InstanceName:      types.StringValue(items.InstanceName),
-			IsDefaultInstance: types.BoolValue(items.IsDefaultInstance),
-			Port:              types.Int32Value(int32(items.Port)),
-			Version:           types.StringValue(items.Version),
-			Template:          types.StringValue(items.Template),
-		})
+	// the API does not filter nor paginate databases, they are filtered here.
+	for _, item := range items {
+		if !data.AssetID.IsNull() && item.AssetID != int(data.AssetID.ValueInt32()) {
+			continue
+		}
+		if !data.PlatformID.IsNull() && item.PlatformID != int(data.PlatformID.ValueInt32()) {
+			continue
+		}
+		if !data.Limit.IsNull() && len(databasesList) >= int(data.Limit.ValueInt32()) {
+			break
+		}
+		databasesList = append(databasesList, newDatabaseModel(item))
 	}
 
-	responseData := DatabaseDataSourceModel{}
+	responseData := data
 	responseData.Databases = databasesList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newDatabaseModel returns the datasource attributes of a database.
+func newDatabaseModel(item entities.DatabaseResponse) DatabaseModel {
+	return DatabaseModel{
+		AssetID:           types.Int32Value(int32(item.AssetID)),
+		DatabaseID:        types.Int32Value(int32(item.DatabaseID)),
+		PlatformID:        types.Int32Value(int32(item.PlatformID)),
+		InstanceName:      types.StringValue(item.InstanceName),
+		IsDefaultInstance: types.BoolValue(item.IsDefaultInstance),
+		Port:              types.Int32Value(int32(item.Port)),
+		Version:           types.StringValue(item.Version),
+		Template:          types.StringValue(item.Template),
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/databases"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &databaseListResource{}
+
+func NewDatabaseListResource() list.ListResource {
+	return &databaseListResource{}
+}
+
+type databaseListResource struct {
+	listResource
+}
+
+type DatabaseListResourceModel struct {
+	AssetID     types.Int32  `tfsdk:"asset_id"`
+	PlatformID  types.Int32  `tfsdk:"platform_id"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+func (l *databaseListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (l *databaseListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Database list resource, lists databases.",
+		Attributes: map[string]schema.Attribute{
+			"asset_id": schema.Int32Attribute{
+				Description: "Lists only the databases of the asset.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"platform_id":  platformIDAttribute("Lists only the databases of the platform."),
+			"name_pattern": namePatternAttribute("Instance name pattern."),
+		},
+	}
+}
+
+func (l *databaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data DatabaseListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	databaseObj, err := databases.NewDatabaseObj(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating database object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := databaseObj.GetDatabasesListFlow()
+	if err != nil {
+		diags.AddError("Error getting databases list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pattern := utils.NewNamePattern(data.NamePattern.ValueString())
+
+	var filtered []entities.DatabaseResponse
+	for _, item := range items {
+		if !data.AssetID.IsNull() && int32(item.AssetID) != data.AssetID.ValueInt32() {
+			continue
+		}
+		if !data.PlatformID.IsNull() && int32(item.PlatformID) != data.PlatformID.ValueInt32() {
+			continue
+		}
+		if pattern.Match(item.InstanceName) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	streamListResults(ctx, req, stream, filtered, func(item entities.DatabaseResponse, result *list.ListResult) {
+		result.DisplayName = item.InstanceName
+		result.Diagnostics.Append(setInt32Identity(ctx, result.Identity, "database_id", types.Int32Value(int32(item.DatabaseID)))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, DatabaseResourceModel{
+				AssetId:           types.StringValue(strconv.Itoa(item.AssetID)),
+				PlatformID:        types.Int32Value(int32(item.PlatformID)),
+				InstanceName:      types.StringValue(item.InstanceName),
+				IsDefaultInstance: types.BoolValue(item.IsDefaultInstance),
+				Port:              types.Int32Value(int32(item.Port)),
+				Version:           types.StringValue(item.Version),
+				Template:          types.StringValue(item.Template),
+				DatabaseID:        types.Int32Value(int32(item.DatabaseID)),
+			})...)
+		}
+	})
+}
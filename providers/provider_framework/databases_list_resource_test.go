@@ -0,0 +1,84 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var DatabaseListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_database" "sql_server" {
+	provider = passwordsafe
+	config {
+		asset_id = 1
+		platform_id = 11
+	}
+	}`,
+}
+
+func TestDatabaseListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/Databases":
+			response = `[{"AssetID":1,"DatabaseID":5,"PlatformID":11,"InstanceName":"primary","Port":1433},{"AssetID":1,"DatabaseID":6,"PlatformID":8,"InstanceName":"oracle","Port":1521},{"AssetID":2,"DatabaseID":7,"PlatformID":11,"InstanceName":"replica","Port":1433}]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	DatabaseListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(DatabaseListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_database.sql_server", 1),
+					querycheck.ExpectIdentity("passwordsafe_database.sql_server", map[string]knownvalue.Check{
+						"database_id": knownvalue.Int32Exact(5),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"context"
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +15,7 @@ import (
 
 var _ resource.Resource = &databaseResource{}
 var _ resource.ResourceWithImportState = &databaseResource{}
+var _ resource.ResourceWithIdentity = &databaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &databaseResource{}
@@ -134,10 +134,12 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	data.DatabaseID = types.Int32Value(int32(createdDataBase.DatabaseID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "database_id", data.DatabaseID)...)
 }
 
 func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "database_id", req, resp)
 }
 
 func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -168,6 +170,10 @@ func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+func (r *databaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("database_id", "Database Id")
+}
+
 func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "database_id", req, resp)
 }
@@ -0,0 +1,43 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// limitFilterAttribute returns the limit attribute of list data sources.
+func limitFilterAttribute(objects string) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		MarkdownDescription: "Maximum number of " + objects + " returned, all of them when not set.",
+		Optional:            true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+	}
+}
+
+// idFilterAttribute returns an ID filter attribute of list data sources.
+func idFilterAttribute(description string) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+	}
+}
+
+// nameFilterAttribute returns a name filter attribute of list data sources.
+func nameFilterAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/functional_accounts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &FunctionalAccountSingleDataSource{}
+
+// functionalAccountFilters are the attributes a functional account is looked up by.
+var functionalAccountFilters = []string{"functional_account_id", "account_name", "domain_name", "platform_id"}
+
+func NewFunctionalAccountSingleDataSource() datasource.DataSource {
+	return &FunctionalAccountSingleDataSource{}
+}
+
+type FunctionalAccountSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *FunctionalAccountSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_functional_account"
+}
+
+func (d *FunctionalAccountSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Functional Account Datasource, gets a functional account by ID, account name, domain name or platform.",
+		Attributes:  singleDataSourceAttributes(getFunctionalAccountDataSourceSchemaAttributes(), functionalAccountFilters),
+	}
+}
+
+func (d *FunctionalAccountSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(functionalAccountFilters)
+}
+
+func (d *FunctionalAccountSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FunctionalAccountModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// instantiating functional account obj
+	functionalAccountObj, _ := functional_accounts.NewFuncionalAccount(*d.providerInfo.authenticationObj, zapLogger)
+
+	// get functional accounts list
+	items, err := functionalAccountObj.GetFunctionalAccountsFlow()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting functional accounts list", err.Error())
+		return
+	}
+
+	item, diags := findSingle("functional account", items,
+		int32Filter("functional_account_id", data.FunctionalAccountID, func(item entities.FunctionalAccountResponse) int { return item.FunctionalAccountID }),
+		stringFilter("account_name", data.AccountName, func(item entities.FunctionalAccountResponse) string { return item.AccountName }),
+		stringFilter("domain_name", data.DomainName, func(item entities.FunctionalAccountResponse) string { return item.DomainName }),
+		int32Filter("platform_id", data.PlatformID, func(item entities.FunctionalAccountResponse) int { return item.PlatformID }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newFunctionalAccountModel(item))...)
+
+}
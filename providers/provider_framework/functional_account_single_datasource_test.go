@@ -0,0 +1,99 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var functionalAccountSingleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_functional_account" "functional_account" {
+		account_name = "svc_windows"
+	}`,
+}
+
+var functionalAccountSingleMultipleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_functional_account" "functional_account" {
+		platform_id = 4
+	}`,
+}
+
+func TestGetFunctionalAccount(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/FunctionalAccounts":
+			response = `[ { "FunctionalAccountID": 1, "PlatformID": 4, "AccountName": "svc_windows" }, { "FunctionalAccountID": 2, "PlatformID": 4, "AccountName": "admin" } ]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	functionalAccountSingleConfig.URL = server.URL
+	functionalAccountSingleMultipleConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				// test getting the only object matching the filters
+				Config: utils.TestResourceConfig(functionalAccountSingleConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_functional_account.functional_account",
+						tfjsonpath.New("functional_account_id"),
+						knownvalue.Int32Exact(1),
+					),
+				},
+			},
+			{
+				// test filters matching more than one object
+				Config:      utils.TestResourceConfig(functionalAccountSingleMultipleConfig),
+				ExpectError: regexp.MustCompile("Multiple functional accounts found"),
+			},
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/functional_accounts"
 )
 
@@ -46,18 +47,23 @@ func (d *FunctionalAccountDataResource) Schema(ctx context.Context, req datasour
 			"accounts": schema.ListNestedBlock{
 				Description: "Functional Account Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"functional_account_id": utils.GetInt32Attribute("Functional Account ID", false, false, true),
-						"platform_id":           utils.GetInt32Attribute("Platform ID", false, false, true),
-						"domain_name":           utils.GetStringAttribute("Domain Name", false, false, true),
-						"account_name":          utils.GetStringAttribute("Account Name", false, false, true),
-					},
+					Attributes: getFunctionalAccountDataSourceSchemaAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// getFunctionalAccountDataSourceSchemaAttributes get schema attributes.
+func getFunctionalAccountDataSourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"functional_account_id": utils.GetInt32Attribute("Functional Account ID", false, false, true),
+		"platform_id":           utils.GetInt32Attribute("Platform ID", false, false, true),
+		"domain_name":           utils.GetStringAttribute("Domain Name", false, false, true),
+		"account_name":          utils.GetStringAttribute("Account Name", false, false, true),
+	}
+}
+
 func (d *FunctionalAccountDataResource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 
 	// getting data from Provider
@@ -94,12 +100,7 @@ func (d *FunctionalAccountDataResource) Read(ctx context.Context, req datasource
 	var accountsList []FunctionalAccountModel
 
 	for _, acc := range items {
-		accountsList = append(accountsList, FunctionalAccountModel{
-			FunctionalAccountID: types.Int32Value(int32(acc.FunctionalAccountID)),
-			PlatformID:          types.Int32Value(int32(acc.PlatformID)),
-			DomainName:          types.StringValue(acc.DomainName),
-			AccountName:         types.StringValue(acc.AccountName),
-		})
+		accountsList = append(accountsList, newFunctionalAccountModel(acc))
 	}
 
 	responseData := FunctionalDataSourceModel{}
@@ -108,3 +109,13 @@ func (d *FunctionalAccountDataResource) Read(ctx context.Context, req datasource
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newFunctionalAccountModel returns the datasource attributes of a functional account.
+func newFunctionalAccountModel(item entities.FunctionalAccountResponse) FunctionalAccountModel {
+	return FunctionalAccountModel{
+		FunctionalAccountID: types.Int32Value(int32(item.FunctionalAccountID)),
+		PlatformID:          types.Int32Value(int32(item.PlatformID)),
+		DomainName:          types.StringValue(item.DomainName),
+		AccountName:         types.StringValue(item.AccountName),
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/functional_accounts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &functionalAccountListResource{}
+
+func NewFunctionalAccountListResource() list.ListResource {
+	return &functionalAccountListResource{}
+}
+
+type functionalAccountListResource struct {
+	listResource
+}
+
+type FunctionalAccountListResourceModel struct {
+	PlatformID  types.Int32  `tfsdk:"platform_id"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+func (l *functionalAccountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_functional_account"
+}
+
+func (l *functionalAccountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Functional account list resource, lists functional accounts. Passwords and keys are not listed.",
+		Attributes: map[string]schema.Attribute{
+			"platform_id":  platformIDAttribute("Lists only the functional accounts of the platform."),
+			"name_pattern": namePatternAttribute("Account name pattern."),
+		},
+	}
+}
+
+func (l *functionalAccountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data FunctionalAccountListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	functionalAccountObj, err := functional_accounts.NewFuncionalAccount(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating functional account object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := functionalAccountObj.GetFunctionalAccountsFlow()
+	if err != nil {
+		diags.AddError("Error getting functional accounts list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pattern := utils.NewNamePattern(data.NamePattern.ValueString())
+
+	var filtered []entities.FunctionalAccountResponse
+	for _, item := range items {
+		if !data.PlatformID.IsNull() && int32(item.PlatformID) != data.PlatformID.ValueInt32() {
+			continue
+		}
+		if pattern.Match(item.AccountName) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	streamListResults(ctx, req, stream, filtered, func(item entities.FunctionalAccountResponse, result *list.ListResult) {
+		result.DisplayName = item.DisplayName
+		result.Diagnostics.Append(setInt32Identity(ctx, result.Identity, "functional_account_id", types.Int32Value(int32(item.FunctionalAccountID)))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, FunctionalResourceResourceModel{
+				FunctionalAccountID: types.Int32Value(int32(item.FunctionalAccountID)),
+				PlatformID:          types.Int32Value(int32(item.PlatformID)),
+				DomainName:          types.StringValue(item.DomainName),
+				AccountName:         types.StringValue(item.AccountName),
+				DisplayName:         types.StringValue(item.DisplayName),
+				Password:            types.StringNull(),
+				PrivateKey:          types.StringNull(),
+				Passphrase:          types.StringNull(),
+				Description:         types.StringValue(item.Description),
+				ElevationCommand:    types.StringValue(item.ElevationCommand),
+				TenantID:            types.StringValue(item.TenantID),
+				ObjectID:            types.StringValue(item.ObjectID),
+				Secret:              types.StringNull(),
+				ServiceAccountEmail: types.StringNull(),
+				AzureInstance:       types.StringValue(item.AzureInstance),
+			})...)
+		}
+	})
+}
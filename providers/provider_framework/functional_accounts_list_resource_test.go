@@ -0,0 +1,84 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var FunctionalAccountListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_functional_account" "service" {
+	provider = passwordsafe
+	config {
+		platform_id = 4
+		name_pattern = "svc_*"
+	}
+	}`,
+}
+
+func TestFunctionalAccountListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/FunctionalAccounts":
+			response = `[{"FunctionalAccountID":1,"PlatformID":4,"AccountName":"svc_windows","DisplayName":"Windows"},{"FunctionalAccountID":2,"PlatformID":2,"AccountName":"svc_linux","DisplayName":"Linux"},{"FunctionalAccountID":3,"PlatformID":4,"AccountName":"admin","DisplayName":"Admin"}]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	FunctionalAccountListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(FunctionalAccountListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_functional_account.service", 1),
+					querycheck.ExpectIdentity("passwordsafe_functional_account.service", map[string]knownvalue.Check{
+						"functional_account_id": knownvalue.Int32Exact(1),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/functional_accounts"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +14,7 @@ import (
 
 var _ resource.Resource = &FunctionalAccountResource{}
 var _ resource.ResourceWithImportState = &FunctionalAccountResource{}
+var _ resource.ResourceWithIdentity = &FunctionalAccountResource{}
 
 func NewFunctionalAccountResource() resource.Resource {
 	return &FunctionalAccountResource{}
@@ -181,11 +181,12 @@ func (r *FunctionalAccountResource) Create(ctx context.Context, req resource.Cre
 	data.FunctionalAccountID = types.Int32Value(int32(createdFunctionalAccount.FunctionalAccountID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "functional_account_id", data.FunctionalAccountID)...)
 }
 
 func (r *FunctionalAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "functional_account_id", req, resp)
 }
 
 func (r *FunctionalAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,6 +217,10 @@ func (r *FunctionalAccountResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *FunctionalAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("functional_account_id", "Functional Account Id")
+}
+
 func (r *FunctionalAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "functional_account_id", req, resp)
 }
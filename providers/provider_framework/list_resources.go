@@ -0,0 +1,93 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"sync"
+
+	providerSdkv2 "terraform-provider-passwordsafe/providers/provider_sdkv2"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listResource holds the provider data of list resources.
+type listResource struct {
+	providerInfo *ProviderData
+}
+
+func (l *listResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	l.providerInfo = &c
+
+}
+
+// namePatternAttribute returns the name_pattern filter of list resources.
+func namePatternAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description + " Case insensitive, * matches any characters and ? matches a single character.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// platformIDAttribute returns the platform_id filter of list resources.
+func platformIDAttribute(description string) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+	}
+}
+
+// streamListResults streams a result for each item, up to the limit of the request. setResult sets the display name,
+// the identity and, when the request includes resources, the resource of the result.
+func streamListResults[T any](ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, items []T, setResult func(item T, result *list.ListResult)) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			setResult(item, &result)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// sdkv2ResourceSchemas returns the protocol 5 schemas and identity schemas of the SDKv2 resources, list resources
+// of SDKv2 resources need them as those resources are not framework resources.
+var sdkv2ResourceSchemas = sync.OnceValues(func() (map[string]*tfprotov5.Schema, map[string]*tfprotov5.ResourceIdentitySchema) {
+	server := sdkv2schema.NewGRPCProviderServer(providerSdkv2.Provider())
+
+	schemas, _ := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	identitySchemas, _ := server.GetResourceIdentitySchemas(context.Background(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+
+	return schemas.ResourceSchemas, identitySchemas.IdentitySchemas
+})
+
+// sdkv2RawV5Schemas sets the protocol 5 schemas of the SDKv2 resource typeName.
+func sdkv2RawV5Schemas(typeName string, resp *list.RawV5SchemaResponse) {
+	schemas, identitySchemas := sdkv2ResourceSchemas()
+
+	resp.ProtoV5Schema = schemas[typeName]
+	resp.ProtoV5IdentitySchema = identitySchemas[typeName]
+}
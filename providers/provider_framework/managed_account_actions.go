@@ -0,0 +1,110 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagedAccountNameModel is a managed_account block of the managed account actions.
+type ManagedAccountNameModel struct {
+	SystemName  types.String `tfsdk:"system_name"`
+	AccountName types.String `tfsdk:"account_name"`
+}
+
+// accountIDsAttribute returns a list of account IDs attribute.
+func accountIDsAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: description,
+		ElementType: types.Int32Type,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueInt32sAre(int32validator.AtLeast(1)),
+		},
+	}
+}
+
+// managedAccountBlock returns the managed_account block selecting managed accounts by system and account names.
+func managedAccountBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"system_name": schema.StringAttribute{
+					Description: "Managed system name",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"account_name": schema.StringAttribute{
+					Description: "Managed account name",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateManagedAccountSelection checks at least one managed account is set in managed_account_ids or in a
+// managed_account block. managed_account blocks are never null, so actionvalidator.AtLeastOneOf cannot be used.
+func validateManagedAccountSelection(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var managedAccountIDs, managedAccounts types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("managed_account_ids"), &managedAccountIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("managed_account"), &managedAccounts)...)
+
+	if resp.Diagnostics.HasError() || managedAccountIDs.IsUnknown() || managedAccounts.IsUnknown() {
+		return
+	}
+
+	if len(managedAccountIDs.Elements()) == 0 && len(managedAccounts.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("managed_account_ids"), "Missing managed accounts",
+			"At least one managed account must be set, either in managed_account_ids or in a managed_account block.")
+	}
+}
+
+// getManagedAccounts gets the managed accounts with managedAccountIDs and the managed accounts of the managed_account blocks.
+func getManagedAccounts(providerInfo *ProviderData, managedAccountIDs []types.Int32, managedAccountNames []ManagedAccountNameModel) ([]entities.ManagedAccountDetails, error) {
+	authenticationObj := *providerInfo.authenticationObj
+	managedAccounts := make([]entities.ManagedAccountDetails, 0, len(managedAccountIDs)+len(managedAccountNames))
+
+	for _, managedAccountID := range managedAccountIDs {
+		managedAccount, err := utils.GetManagedAccountByID(authenticationObj, int(managedAccountID.ValueInt32()), zapLogger)
+		if err != nil {
+			return nil, fmt.Errorf("managed account %v: %w", managedAccountID.ValueInt32(), err)
+		}
+		managedAccounts = append(managedAccounts, managedAccount)
+	}
+
+	for _, name := range managedAccountNames {
+		managedAccount, err := utils.GetManagedAccountByName(authenticationObj, name.SystemName.ValueString(), name.AccountName.ValueString(), zapLogger)
+		if err != nil {
+			return nil, fmt.Errorf("managed account %v/%v: %w", name.SystemName.ValueString(), name.AccountName.ValueString(), err)
+		}
+		managedAccounts = append(managedAccounts, managedAccount)
+	}
+	return managedAccounts, nil
+}
+
+// describeManagedAccount returns the managed account ID and name used in progress messages and diagnostics.
+func describeManagedAccount(managedAccount entities.ManagedAccountDetails) string {
+	return fmt.Sprintf("managed account %v (%v)", managedAccount.ManagedAccountID, managedAccount.AccountName)
+}
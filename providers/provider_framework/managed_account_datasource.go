@@ -6,13 +6,17 @@ import (
 	"context"
 	"terraform-provider-passwordsafe/providers/utils"
 
-	managed_accounts "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_account"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &ManagedAccountDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ManagedAccountDataSource{}
 
 func NewManagedAccountDataSource() datasource.DataSource {
 	return &ManagedAccountDataSource{}
@@ -51,11 +55,33 @@ type ManagedAccountModel struct {
 
 type ManagedAccountDataSourceModel struct {
 	ManagedAccounts []ManagedAccountModel `tfsdk:"managed_accounts"`
+	SystemName      types.String          `tfsdk:"system_name"`
+	AccountName     types.String          `tfsdk:"account_name"`
+	WorkgroupName   types.String          `tfsdk:"workgroup_name"`
+	WorkgroupID     types.Int32           `tfsdk:"workgroup_id"`
+	PlatformID      types.Int32           `tfsdk:"platform_id"`
+	Type            types.String          `tfsdk:"type"`
+	Limit           types.Int32           `tfsdk:"limit"`
 }
 
 func (d *ManagedAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Managed Account Datasource, get managed accounts list.",
+		Attributes: map[string]schema.Attribute{
+			"system_name":    nameFilterAttribute("Returns only the managed accounts of the managed system with this name."),
+			"account_name":   nameFilterAttribute("Returns only the managed accounts with this name."),
+			"workgroup_name": nameFilterAttribute("Returns only the managed accounts of the workgroup with this name."),
+			"workgroup_id":   idFilterAttribute("Returns only the managed accounts of the workgroup, the API filters managed accounts by workgroup name so the ID is resolved to the workgroup name first."),
+			"platform_id":    idFilterAttribute("Returns only the managed accounts of the platform."),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Returns only the managed accounts of this type: system, recent, domainlinked, database, cloud or application.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("system", "recent", "domainlinked", "database", "cloud", "application"),
+				},
+			},
+			"limit": limitFilterAttribute("managed accounts"),
+		},
 		Blocks: map[string]schema.Block{
 			"managed_accounts": schema.ListNestedBlock{
 				Description: "Managed Account Datasource Attributes",
@@ -67,6 +93,15 @@ func (d *ManagedAccountDataSource) Schema(ctx context.Context, req datasource.Sc
 	}
 }
 
+func (d *ManagedAccountDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("workgroup_name"),
+			path.MatchRoot("workgroup_id"),
+		),
+	}
+}
+
 // getAssetDataSourceSchemaAttributes get schema attributes.
 func (d *ManagedAccountDataSource) getManagedAccountDataSourceSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
@@ -114,11 +149,17 @@ func (d *ManagedAccountDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	// instantiating managed acocunt obj.
-	managedAccountObj, _ := managed_accounts.NewManagedAccountObj(*d.providerInfo.authenticationObj, zapLogger)
+	filter := utils.ManagedAccountsFilter{
+		SystemName:    data.SystemName.ValueString(),
+		AccountName:   data.AccountName.ValueString(),
+		WorkgroupName: data.WorkgroupName.ValueString(),
+		WorkgroupID:   int(data.WorkgroupID.ValueInt32()),
+		Type:          data.Type.ValueString(),
+		PlatformID:    int(data.PlatformID.ValueInt32()),
+	}
 
-	// get managed accounts list.
-	items, err := managedAccountObj.GetManagedAccountsListFlow()
+	// get managed accounts list, filtered by the API and paginated.
+	items, err := utils.GetManagedAccountsList(*d.providerInfo.authenticationObj, filter, int(data.Limit.ValueInt32()), zapLogger)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting managed accounts list", err.Error())
@@ -151,7 +192,7 @@ func (d *ManagedAccountDataSource) Read(ctx context.Context, req datasource.Read
 		})
 	}
 
-	responseData := ManagedAccountDataSourceModel{}
+	responseData := data
 	responseData.ManagedAccounts = managedAccountList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
@@ -156,3 +156,88 @@ func TestGetManagedAccountsListNotFound(t *testing.T) {
 		},
 	})
 }
+
+func TestGetManagedAccountsListFiltered(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, err := w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, err := w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/ManagedAccounts":
+			// filters are sent to the API
+			query := r.URL.Query()
+			if query.Get("systemName") != "system01" || query.Get("type") != "system" || query.Get("limit") == "" {
+				t.Errorf("unexpected query %v", r.URL.RawQuery)
+			}
+
+			_, err := w.Write([]byte(`[{"PlatformID": 4, "SystemId": 1, "SystemName": "system01", "AccountId": 10, "AccountName": "account01"},
+				{"PlatformID": 2, "SystemId": 1, "SystemName": "system01", "AccountId": 11, "AccountName": "account02"},
+				{"PlatformID": 4, "SystemId": 1, "SystemName": "system01", "AccountId": 12, "AccountName": "account03"}]`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/Signout":
+			_, err := w.Write([]byte(``))
+			if err != nil {
+				t.Error(err.Error())
+			}
+		}
+
+	}))
+
+	server.URL = server.URL + constants.APIPath
+
+	config := managedAccountsListConfig
+	config.URL = server.URL
+	config.Resource = `
+		data "passwordsafe_managed_account_datasource" "managed_accounts_list" {
+			system_name = "system01"
+			type        = "system"
+			platform_id = 4
+			limit       = 1
+		}`
+
+	resource.Test(t, resource.TestCase{
+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// test using oauth authentication, get filtered managed accounts list
+				Config: utils.TestResourceConfig(config),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_managed_account_datasource.managed_accounts_list",
+						tfjsonpath.New("managed_accounts"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_managed_account_datasource.managed_accounts_list",
+						tfjsonpath.New("managed_accounts").AtSliceIndex(0).AtMapKey("account_name"),
+						knownvalue.StringExact("account01"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -4,16 +4,27 @@ package provider_framework
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"time"
 
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	managed_accounts "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_account"
 )
 
+var _ ephemeral.EphemeralResourceWithConfigValidators = &EphemeralManagedAccount{}
+
 // @EphemeralResource(passwordsafe_managed_acccount_ephemeral, name="Secret Version")
 func NewEphemeralManagedAccount() ephemeral.EphemeralResource {
 	return &EphemeralManagedAccount{}
@@ -24,11 +35,23 @@ type EphemeralManagedAccount struct {
 }
 
 type EphemeralManagedAccountModel struct {
-	SystemName  types.String `tfsdk:"system_name"`
-	AccountName types.String `tfsdk:"account_name"`
-	Value       types.String `tfsdk:"value"`
+	SystemName       types.String `tfsdk:"system_name"`
+	AccountName      types.String `tfsdk:"account_name"`
+	AliasName        types.String `tfsdk:"alias_name"`
+	ManagedAccountID types.Int32  `tfsdk:"managed_account_id"`
+	ManagedSystemID  types.Int32  `tfsdk:"managed_system_id"`
+	Separator        types.String `tfsdk:"separator"`
+	WaitForApproval  types.Bool   `tfsdk:"wait_for_approval"`
+	ApprovalTimeout  types.Int32  `tfsdk:"approval_timeout"`
+	PollInterval     types.Int32  `tfsdk:"poll_interval"`
+	Value            types.String `tfsdk:"value"`
 }
 
+var (
+	defaultApprovalTimeoutInSeconds = 1800
+	defaultPollIntervalInSeconds    = 30
+)
+
 func (e *EphemeralManagedAccount) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_managed_acccount_ephemeral"
 }
@@ -36,23 +59,72 @@ func (e *EphemeralManagedAccount) Metadata(ctx context.Context, request ephemera
 func (e *EphemeralManagedAccount) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
 	response.Schema = schema.Schema{
 
-		MarkdownDescription: "Managed Account Ephemeral Resource, gets managed account.",
+		MarkdownDescription: "Managed Account Ephemeral Resource, gets managed account by system name and account name, managed account ID, managed system ID and account name, or by alias name.",
 
 		Attributes: map[string]schema.Attribute{
 			"system_name": schema.StringAttribute{
 				Description: "System account name",
-				Required:    true,
+				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.AlsoRequires(path.MatchRoot("account_name")),
 				},
 			},
 			"account_name": schema.StringAttribute{
 				Description: "Managed account name",
-				Required:    true,
+				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 245),
 				},
 			},
+			"managed_account_id": schema.Int32Attribute{
+				Description: "Managed account ID",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"managed_system_id": schema.Int32Attribute{
+				Description: "Managed system ID, used together with account_name",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRoot("account_name")),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Separator used to join system_name and account_name (default: /)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("system_name")),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Description: "Alias name, gets the credential of the managed account the alias currently points to",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"wait_for_approval": schema.BoolAttribute{
+				Description: "Whether to wait until the access request is approved when the managed account requires approval, the request ID and approver comments are reported as a warning.",
+				Optional:    true,
+			},
+			"approval_timeout": schema.Int32Attribute{
+				Description: fmt.Sprintf("Maximum time in seconds to wait for the access request approval (default: %d), the request is checked in when it is still pending.", defaultApprovalTimeoutInSeconds),
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"poll_interval": schema.Int32Attribute{
+				Description: fmt.Sprintf("Time in seconds between access request status checks while waiting for approval (default: %d).", defaultPollIntervalInSeconds),
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
 			"value": schema.StringAttribute{
 				Description: "Value",
 				Computed:    true,
@@ -61,6 +133,26 @@ func (e *EphemeralManagedAccount) Schema(ctx context.Context, _ ephemeral.Schema
 		},
 	}
 }
+
+func (e *EphemeralManagedAccount) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("system_name"),
+			path.MatchRoot("alias_name"),
+			path.MatchRoot("managed_account_id"),
+			path.MatchRoot("managed_system_id"),
+		),
+		ephemeralvalidator.Conflicting(
+			path.MatchRoot("alias_name"),
+			path.MatchRoot("account_name"),
+		),
+		ephemeralvalidator.Conflicting(
+			path.MatchRoot("managed_account_id"),
+			path.MatchRoot("account_name"),
+		),
+	}
+}
+
 func (e *EphemeralManagedAccount) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
 
 	// getting data from Provider
@@ -92,8 +184,15 @@ func (e *EphemeralManagedAccount) Open(ctx context.Context, request ephemeral.Op
 		return
 	}
 
-	// getting single managed account from PS API
-	gotManagedAccount, err := manageAccountObj.GetSecret(data.SystemName.ValueString()+"/"+data.AccountName.ValueString(), "/")
+	// getting managed account from PS API, or from the credential cache when enabled
+	cacheKey := utils.ManagedAccountCacheKey(data.SystemName.ValueString(), data.AccountName.ValueString(), int(data.ManagedAccountID.ValueInt32()), int(data.ManagedSystemID.ValueInt32()), data.AliasName.ValueString())
+	gotManagedAccount, warnings, err := utils.GetCachedCredentialWithWarnings(e.providerInfo.authenticationObj, cacheKey, func() (string, []utils.CredentialWarning, error) {
+		return e.getManagedAccountValue(ctx, manageAccountObj, data)
+	})
+
+	for _, warning := range warnings {
+		response.Diagnostics.AddWarning(warning.Summary, warning.Detail)
+	}
 
 	if err != nil {
 		response.Diagnostics.AddError("Error getting managed account", err.Error())
@@ -106,3 +205,125 @@ func (e *EphemeralManagedAccount) Open(ctx context.Context, request ephemeral.Op
 	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
 
 }
+
+// getManagedAccountValue gets the managed account credential, requesting it by IDs or alias and waiting for
+// the request approval when needed. The warnings raised while waiting for the approval are returned.
+func (e *EphemeralManagedAccount) getManagedAccountValue(ctx context.Context, manageAccountObj *managed_accounts.ManagedAccountstObj, data EphemeralManagedAccountModel) (string, []utils.CredentialWarning, error) {
+	if data.SystemName.IsNull() || data.WaitForApproval.ValueBool() {
+		return e.getSecretByRequest(ctx, manageAccountObj, data)
+	}
+
+	separator := getSeparator(data.Separator)
+	value, err := utils.RetryAfterSessionRenewal(e.providerInfo.authenticationObj, func() (string, error) {
+		return manageAccountObj.GetSecret(data.SystemName.ValueString()+separator+data.AccountName.ValueString(), separator)
+	})
+	return value, nil, err
+}
+
+// createAccessRequest creates an access request for the alias or for the managed account and returns the request ID.
+func (e *EphemeralManagedAccount) createAccessRequest(manageAccountObj *managed_accounts.ManagedAccountstObj, data EphemeralManagedAccountModel) (string, error) {
+	authenticationObj := *e.providerInfo.authenticationObj
+
+	if data.AliasName.ValueString() != "" {
+		alias, err := utils.GetAliasByName(authenticationObj, data.AliasName.ValueString(), zapLogger)
+		if err != nil {
+			return "", err
+		}
+		return utils.CreateAliasAccessRequest(authenticationObj, alias.AliasId, zapLogger)
+	}
+
+	managedAccount, err := e.getManagedAccount(manageAccountObj, data)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.CreateManagedAccountAccessRequest(authenticationObj, managedAccount.ManagedSystemID, managedAccount.ManagedAccountID, zapLogger)
+}
+
+// getManagedAccount gets the managed account by managed account ID, by managed system ID and account name or by system and account names.
+func (e *EphemeralManagedAccount) getManagedAccount(manageAccountObj *managed_accounts.ManagedAccountstObj, data EphemeralManagedAccountModel) (entities.ManagedAccountDetails, error) {
+	authenticationObj := *e.providerInfo.authenticationObj
+
+	switch {
+	case !data.ManagedAccountID.IsNull():
+		return utils.GetManagedAccountByID(authenticationObj, int(data.ManagedAccountID.ValueInt32()), zapLogger)
+	case !data.ManagedSystemID.IsNull():
+		return utils.GetManagedAccountBySystemID(authenticationObj, int(data.ManagedSystemID.ValueInt32()), data.AccountName.ValueString(), zapLogger)
+	}
+
+	v := url.Values{}
+	v.Add("systemName", data.SystemName.ValueString())
+	v.Add("accountName", data.AccountName.ValueString())
+
+	managedAccount, err := manageAccountObj.ManagedAccountGet(data.SystemName.ValueString(), data.AccountName.ValueString(), authenticationObj.ApiUrl.JoinPath("ManagedAccounts").String()+"?"+v.Encode())
+	if err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	return entities.ManagedAccountDetails{ManagedSystemID: managedAccount.SystemId, ManagedAccountID: managedAccount.AccountId}, nil
+}
+
+// getSecretByRequest creates an access request, waits until it is approved, denied or
+// expired when wait_for_approval is set and returns the credential.
+func (e *EphemeralManagedAccount) getSecretByRequest(ctx context.Context, manageAccountObj *managed_accounts.ManagedAccountstObj, data EphemeralManagedAccountModel) (string, []utils.CredentialWarning, error) {
+	requestID, err := utils.RetryAfterSessionRenewal(e.providerInfo.authenticationObj, func() (string, error) {
+		return e.createAccessRequest(manageAccountObj, data)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var warnings []utils.CredentialWarning
+	if data.WaitForApproval.ValueBool() {
+		if warnings, err = e.waitForApproval(ctx, requestID, data); err != nil {
+			return "", warnings, err
+		}
+	}
+
+	value, err := utils.GetCredentialByRequest(*e.providerInfo.authenticationObj, requestID, zapLogger)
+	return value, warnings, err
+}
+
+// waitForApproval waits until the access request is approved, denied or expired and returns a warning with the
+// request ID and the approver comments, whatever the outcome of the wait.
+func (e *EphemeralManagedAccount) waitForApproval(ctx context.Context, requestID string, data EphemeralManagedAccountModel) ([]utils.CredentialWarning, error) {
+	timeout := time.Duration(getInt32OrDefault(data.ApprovalTimeout, defaultApprovalTimeoutInSeconds)) * time.Second
+	pollInterval := time.Duration(getInt32OrDefault(data.PollInterval, defaultPollIntervalInSeconds)) * time.Second
+
+	accessRequest, err := utils.WaitForAccessRequestApproval(ctx, *e.providerInfo.authenticationObj, requestID, timeout, pollInterval, zapLogger)
+	if err != nil {
+		return []utils.CredentialWarning{accessRequestWarning("Access request not approved", fmt.Sprintf("Access request %v was not approved while waiting", requestID), "")},
+			fmt.Errorf("access request %v: %w", requestID, err)
+	}
+
+	if !utils.IsAccessRequestApproved(accessRequest) {
+		return []utils.CredentialWarning{accessRequestWarning("Access request not approved", fmt.Sprintf("Access request %v was %v", requestID, accessRequest.Status), accessRequest.ApproverComments)},
+			fmt.Errorf("access request %v was %v, approver comments: %q", requestID, accessRequest.Status, accessRequest.ApproverComments)
+	}
+
+	return []utils.CredentialWarning{accessRequestWarning("Access request approved", fmt.Sprintf("Access request %v was approved", requestID), accessRequest.ApproverComments)}, nil
+}
+
+// accessRequestWarning returns the warning reporting an access request, the approver comments are added when set.
+func accessRequestWarning(summary string, detail string, approverComments string) utils.CredentialWarning {
+	if approverComments != "" {
+		detail += fmt.Sprintf(", approver comments: %q", approverComments)
+	}
+	return utils.CredentialWarning{Summary: summary, Detail: detail}
+}
+
+// getInt32OrDefault get int32 attribute value or default value when attribute is not set.
+func getInt32OrDefault(value types.Int32, defaultValue int) int {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+	return int(value.ValueInt32())
+}
+
+// getSeparator get separator attribute value or default separator when attribute is not set.
+func getSeparator(value types.String) string {
+	if value.ValueString() == "" {
+		return "/"
+	}
+	return value.ValueString()
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"regexp"
+	"sync/atomic"
 	"terraform-provider-passwordsafe/providers/constants"
 	"terraform-provider-passwordsafe/providers/entities"
 	"terraform-provider-passwordsafe/providers/utils"
@@ -181,3 +182,286 @@ func TestEphemeralManagedAcountNotFound(t *testing.T) {
 		},
 	})
 }
+
+var ManganedAccountEphemeralApprovalConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	ephemeral "passwordsafe_managed_acccount_ephemeral" "test" {
+	system_name = "server01"
+	account_name = "managed_account_01"
+	wait_for_approval = true
+	approval_timeout = 10
+	poll_interval = 1
+	}
+
+	provider "echo" {
+	data = ephemeral.passwordsafe_managed_acccount_ephemeral.test
+	}
+
+	resource "echo" "test" {}`,
+}
+
+// newApprovalMockServer mocks an access request that stays pending for the first
+// status check and then moves to the given status.
+func newApprovalMockServer(t *testing.T, finalStatus string) *httptest.Server {
+	t.Helper()
+	var statusChecks int32
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/ManagedAccounts":
+			_, _ = w.Write([]byte(`{"SystemId":1,"AccountId":10}`))
+
+		case constants.APIPath + "/Requests":
+			if r.Method == http.MethodPost {
+				_, _ = w.Write([]byte(`124`))
+				return
+			}
+			status := "pending"
+			if atomic.AddInt32(&statusChecks, 1) > 1 {
+				status = finalStatus
+			}
+			_, _ = w.Write([]byte(`[{"RequestID":124,"SystemID":1,"AccountID":10,"Status":"` + status + `","ApproverComments":"change ticket CHG-1"}]`))
+
+		case constants.APIPath + "/Credentials/124":
+			_, _ = w.Write([]byte(`"fake_credential"`))
+
+		case constants.APIPath + "/Requests/124/checkin":
+			_, _ = w.Write([]byte(``))
+		}
+	}))
+}
+
+func TestEphemeralManagedAcountWaitForApproval(t *testing.T) {
+
+	// mocking Password Safe API
+	server := newApprovalMockServer(t, "active")
+
+	server.URL = server.URL + constants.APIPath
+	ManganedAccountEphemeralApprovalConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config: utils.TestResourceConfig(ManganedAccountEphemeralApprovalConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("value"),
+						knownvalue.StringExact("fake_credential"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestEphemeralManagedAcountApprovalDenied(t *testing.T) {
+
+	// mocking Password Safe API
+	server := newApprovalMockServer(t, "denied")
+
+	server.URL = server.URL + constants.APIPath
+	ManganedAccountEphemeralApprovalConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config:      utils.TestResourceConfig(ManganedAccountEphemeralApprovalConfig),
+				ExpectError: regexp.MustCompile("access request 124 was denied"),
+			},
+		},
+	})
+}
+
+var ManganedAccountEphemeralAliasConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	ephemeral "passwordsafe_managed_acccount_ephemeral" "test" {
+	alias_name = "db_admin"
+	}
+
+	provider "echo" {
+	data = ephemeral.passwordsafe_managed_acccount_ephemeral.test
+	}
+
+	resource "echo" "test" {}`,
+}
+
+func TestEphemeralManagedAcountByAlias(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/Aliases":
+			_, _ = w.Write([]byte(`{"AliasId": 3, "AliasName": "db_admin", "AliasState": 2, "SystemId": 5, "AccountId": 12}`))
+
+		case constants.APIPath + "/Aliases/3/Requests":
+			_, _ = w.Write([]byte(`125`))
+
+		case constants.APIPath + "/Credentials/125":
+			_, _ = w.Write([]byte(`"alias_credential"`))
+
+		case constants.APIPath + "/Requests/125/checkin":
+			_, _ = w.Write([]byte(``))
+		}
+	}))
+
+	server.URL = server.URL + constants.APIPath
+	ManganedAccountEphemeralAliasConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config: utils.TestResourceConfig(ManganedAccountEphemeralAliasConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("value"),
+						knownvalue.StringExact("alias_credential"),
+					),
+				},
+			},
+		},
+	})
+}
+
+var ManganedAccountEphemeralByIDConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	ephemeral "passwordsafe_managed_acccount_ephemeral" "test" {
+	managed_system_id = 1
+	account_name = "domain/admin"
+	}
+
+	provider "echo" {
+	data = ephemeral.passwordsafe_managed_acccount_ephemeral.test
+	}
+
+	resource "echo" "test" {}`,
+}
+
+func TestEphemeralManagedAcountByID(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/ManagedSystems/1/ManagedAccounts":
+			_, _ = w.Write([]byte(`{"ManagedAccountID": 10, "ManagedSystemID": 1, "AccountName": "domain/admin"}`))
+
+		case constants.APIPath + "/Requests":
+			_, _ = w.Write([]byte(`124`))
+
+		case constants.APIPath + "/Credentials/124":
+			_, _ = w.Write([]byte(`"fake_credential"`))
+
+		case constants.APIPath + "/Requests/124/checkin":
+			_, _ = w.Write([]byte(``))
+		}
+	}))
+
+	server.URL = server.URL + constants.APIPath
+	ManganedAccountEphemeralByIDConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config: utils.TestResourceConfig(ManganedAccountEphemeralByIDConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("value"),
+						knownvalue.StringExact("fake_credential"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccessRequestWarning(t *testing.T) {
+	warning := accessRequestWarning("Access request approved", "Access request 124 was approved", "")
+	if warning.Detail != "Access request 124 was approved" {
+		t.Errorf("Unexpected warning detail %q", warning.Detail)
+	}
+
+	warning = accessRequestWarning("Access request not approved", "Access request 124 was denied", "not now")
+	if warning.Detail != `Access request 124 was denied, approver comments: "not now"` {
+		t.Errorf("Unexpected warning detail %q", warning.Detail)
+	}
+}
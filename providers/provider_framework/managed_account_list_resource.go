@@ -0,0 +1,113 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	managed_accounts "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_account"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &managedAccountListResource{}
+var _ list.ListResourceWithRawV5Schemas = &managedAccountListResource{}
+
+func NewManagedAccountListResource() list.ListResource {
+	return &managedAccountListResource{}
+}
+
+type managedAccountListResource struct {
+	listResource
+}
+
+type ManagedAccountListResourceModel struct {
+	PlatformID        types.Int32  `tfsdk:"platform_id"`
+	SystemNamePattern types.String `tfsdk:"system_name_pattern"`
+	NamePattern       types.String `tfsdk:"name_pattern"`
+}
+
+func (l *managedAccountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_account"
+}
+
+func (l *managedAccountListResource) RawV5Schemas(ctx context.Context, req list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkv2RawV5Schemas("passwordsafe_managed_account", resp)
+}
+
+func (l *managedAccountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Managed account list resource, lists managed accounts. Passwords and keys are not listed.",
+		Attributes: map[string]schema.Attribute{
+			"platform_id":         platformIDAttribute("Lists only the managed accounts of the platform."),
+			"system_name_pattern": namePatternAttribute("Managed system name pattern."),
+			"name_pattern":        namePatternAttribute("Account name pattern."),
+		},
+	}
+}
+
+func (l *managedAccountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ManagedAccountListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	managedAccountObj, err := managed_accounts.NewManagedAccountObj(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating managed account object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := managedAccountObj.GetManagedAccountsListFlow()
+	if err != nil {
+		diags.AddError("Error getting managed accounts list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	systemNamePattern := utils.NewNamePattern(data.SystemNamePattern.ValueString())
+	namePattern := utils.NewNamePattern(data.NamePattern.ValueString())
+
+	var filtered []entities.ManagedAccount
+	for _, item := range items {
+		if !data.PlatformID.IsNull() && int32(item.PlatformID) != data.PlatformID.ValueInt32() {
+			continue
+		}
+		if systemNamePattern.Match(item.SystemName) && namePattern.Match(item.AccountName) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	streamListResults(ctx, req, stream, filtered, func(item entities.ManagedAccount, result *list.ListResult) {
+		id := strconv.Itoa(item.AccountId)
+
+		result.DisplayName = item.SystemName + "/" + item.AccountName
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), id)...)
+		if req.IncludeResource {
+			attributes := map[string]any{
+				"id":                   id,
+				"system_name":          item.SystemName,
+				"account_name":         item.AccountName,
+				"domain_name":          item.DomainName,
+				"user_principal_name":  item.UserPrincipalName,
+				"description":          item.AccountDescription,
+				"release_duration":     item.DefaultReleaseDuration,
+				"max_release_duration": item.MaximumReleaseDuration,
+			}
+			for name, value := range attributes {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+			}
+		}
+	})
+}
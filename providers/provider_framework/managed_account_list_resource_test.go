@@ -0,0 +1,106 @@
+package provider_framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	providerSdkv2 "terraform-provider-passwordsafe/providers/provider_sdkv2"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var ManagedAccountListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_managed_account" "administrators" {
+	provider = passwordsafe
+	config {
+		system_name_pattern = "server*"
+		name_pattern = "admin*"
+	}
+	}`,
+}
+
+// muxProtoV5ProviderFactories returns the factories of the muxed provider, list resources of SDKv2 resources
+// need both providers.
+func muxProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"passwordsafe": func() (tfprotov5.ProviderServer, error) {
+			muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+				providerserver.NewProtocol5(NewProvider()),
+				providerSdkv2.Provider().GRPCProvider,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
+
+func TestManagedAccountListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/ManagedAccounts":
+			response = `[
+				{"PlatformID":1,"SystemId":1,"SystemName":"server01","AccountId":10,"AccountName":"administrator"},
+				{"PlatformID":1,"SystemId":1,"SystemName":"server01","AccountId":11,"AccountName":"backup"},
+				{"PlatformID":1,"SystemId":2,"SystemName":"workstation01","AccountId":12,"AccountName":"administrator"}
+			]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	ManagedAccountListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() {},
+		ProtoV5ProviderFactories: muxProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(ManagedAccountListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_managed_account.administrators", 1),
+					querycheck.ExpectIdentity("passwordsafe_managed_account.administrators", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact("10"),
+					}),
+				},
+			},
+		},
+	})
+}
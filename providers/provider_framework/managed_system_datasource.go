@@ -6,9 +6,11 @@ import (
 	"context"
 	"terraform-provider-passwordsafe/providers/utils"
 
-	managed_systems "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_systems"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -77,68 +79,91 @@ type ManagedSystemModel struct {
 
 type ManagedSystemDataSourceModel struct {
 	ManagedSystems []ManagedSystemModel `tfsdk:"managed_systems"`
+	SystemName     types.String         `tfsdk:"system_name"`
+	Type           types.Int32          `tfsdk:"type"`
+	WorkgroupID    types.Int32          `tfsdk:"workgroup_id"`
+	PlatformID     types.Int32          `tfsdk:"platform_id"`
+	Limit          types.Int32          `tfsdk:"limit"`
 }
 
 func (d *ManagedSystemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Managed System Datasource, get managed systems list.",
+		Attributes: map[string]schema.Attribute{
+			"system_name": nameFilterAttribute("Returns only the managed systems with this name."),
+			"type": schema.Int32Attribute{
+				MarkdownDescription: "Returns only the managed systems of this entity type: 1 asset, 2 database, 3 directory or 4 cloud.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 4),
+				},
+			},
+			"workgroup_id": idFilterAttribute("Returns only the managed systems of the workgroup."),
+			"platform_id":  idFilterAttribute("Returns only the managed systems of the platform."),
+			"limit":        limitFilterAttribute("managed systems"),
+		},
 		Blocks: map[string]schema.Block{
 			"managed_systems": schema.ListNestedBlock{
 				Description: "Managed System Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"workgroup_id":                           utils.GetInt32Attribute("Workgroup ID", false, false, true),
-						"host_name":                              utils.GetStringAttribute("Host Name", false, false, true),
-						"ip_address":                             utils.GetStringAttribute("IP Address", false, false, true),
-						"dns_name":                               utils.GetStringAttribute("DNS Name", false, false, true),
-						"instance_name":                          utils.GetStringAttribute("Instance Name", false, false, true),
-						"is_default_instance":                    utils.GetBoolAttribute("Is Default Instance", false, false, true),
-						"template":                               utils.GetStringAttribute("Template", false, false, true),
-						"forest_name":                            utils.GetStringAttribute("Forest Name", false, false, true),
-						"use_ssl":                                utils.GetBoolAttribute("Use SSL", false, false, true),
-						"managed_system_id":                      utils.GetInt32Attribute("Managed System ID", false, false, true),
-						"entity_type_id":                         utils.GetInt32Attribute("Entity Type ID", false, false, true),
-						"asset_id":                               utils.GetInt32Attribute("Asset ID", false, false, true),
-						"database_id":                            utils.GetInt32Attribute("Database ID", false, false, true),
-						"directory_id":                           utils.GetInt32Attribute("Directory ID", false, false, true),
-						"cloud_id":                               utils.GetInt32Attribute("Cloud ID", false, false, true),
-						"system_name":                            utils.GetStringAttribute("System Name", false, false, true),
-						"timeout":                                utils.GetInt32Attribute("Timeout", false, false, true),
-						"platform_id":                            utils.GetInt32Attribute("Platform ID", false, false, true),
-						"net_bios_name":                          utils.GetStringAttribute("NetBIOS Name", false, false, true),
-						"contact_email":                          utils.GetStringAttribute("Contact Email", false, false, true),
-						"description":                            utils.GetStringAttribute("Description", false, false, true),
-						"port":                                   utils.GetInt32Attribute("Port", false, false, true),
-						"ssh_key_enforcement_mode":               utils.GetInt32Attribute("SSH Key Enforcement Mode", false, false, true),
-						"password_rule_id":                       utils.GetInt32Attribute("Password Rule ID", false, false, true),
-						"dss_key_rule_id":                        utils.GetInt32Attribute("DSS Key Rule ID", false, false, true),
-						"login_account_id":                       utils.GetInt32Attribute("Login Account ID", false, false, true),
-						"account_name_format":                    utils.GetInt32Attribute("Account Name Format", false, false, true),
-						"oracle_internet_directory_id":           utils.GetStringAttribute("Oracle Internet Directory ID (GUID)", false, false, true),
-						"oracle_internet_directory_service_name": utils.GetStringAttribute("Oracle Internet Directory Service Name", false, false, true),
-						"release_duration":                       utils.GetInt32Attribute("Release Duration", false, false, true),
-						"max_release_duration":                   utils.GetInt32Attribute("Max Release Duration", false, false, true),
-						"isa_release_duration":                   utils.GetInt32Attribute("ISA Release Duration", false, false, true),
-						"auto_management_flag":                   utils.GetBoolAttribute("Auto Management Flag", false, false, true),
-						"functional_account_id":                  utils.GetInt32Attribute("Functional Account ID", false, false, true),
-						"elevation_command":                      utils.GetStringAttribute("Elevation Command", false, false, true),
-						"check_password_flag":                    utils.GetBoolAttribute("Check Password Flag", false, false, true),
-						"change_password_after_any_release_flag": utils.GetBoolAttribute("Change Password After Any Release Flag", false, false, true),
-						"reset_password_on_mismatch_flag":        utils.GetBoolAttribute("Reset Password On Mismatch Flag", false, false, true),
-						"change_frequency_type":                  utils.GetStringAttribute("Change Frequency Type", false, false, true),
-						"change_frequency_days":                  utils.GetInt32Attribute("Change Frequency Days", false, false, true),
-						"change_time":                            utils.GetStringAttribute("Change Time", false, false, true),
-						"remote_client_type":                     utils.GetStringAttribute("Remote Client Type", false, false, true),
-						"application_host_id":                    utils.GetInt32Attribute("Application Host ID", false, false, true),
-						"is_application_host":                    utils.GetBoolAttribute("Is Application Host", false, false, true),
-						"access_url":                             utils.GetStringAttribute("Access URL", false, false, true),
-					},
+					Attributes: getManagedSystemDataSourceSchemaAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// getManagedSystemDataSourceSchemaAttributes get schema attributes.
+func getManagedSystemDataSourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workgroup_id":                           utils.GetInt32Attribute("Workgroup ID", false, false, true),
+		"host_name":                              utils.GetStringAttribute("Host Name", false, false, true),
+		"ip_address":                             utils.GetStringAttribute("IP Address", false, false, true),
+		"dns_name":                               utils.GetStringAttribute("DNS Name", false, false, true),
+		"instance_name":                          utils.GetStringAttribute("Instance Name", false, false, true),
+		"is_default_instance":                    utils.GetBoolAttribute("Is Default Instance", false, false, true),
+		"template":                               utils.GetStringAttribute("Template", false, false, true),
+		"forest_name":                            utils.GetStringAttribute("Forest Name", false, false, true),
+		"use_ssl":                                utils.GetBoolAttribute("Use SSL", false, false, true),
+		"managed_system_id":                      utils.GetInt32Attribute("Managed System ID", false, false, true),
+		"entity_type_id":                         utils.GetInt32Attribute("Entity Type ID", false, false, true),
+		"asset_id":                               utils.GetInt32Attribute("Asset ID", false, false, true),
+		"database_id":                            utils.GetInt32Attribute("Database ID", false, false, true),
+		"directory_id":                           utils.GetInt32Attribute("Directory ID", false, false, true),
+		"cloud_id":                               utils.GetInt32Attribute("Cloud ID", false, false, true),
+		"system_name":                            utils.GetStringAttribute("System Name", false, false, true),
+		"timeout":                                utils.GetInt32Attribute("Timeout", false, false, true),
+		"platform_id":                            utils.GetInt32Attribute("Platform ID", false, false, true),
+		"net_bios_name":                          utils.GetStringAttribute("NetBIOS Name", false, false, true),
+		"contact_email":                          utils.GetStringAttribute("Contact Email", false, false, true),
+		"description":                            utils.GetStringAttribute("Description", false, false, true),
+		"port":                                   utils.GetInt32Attribute("Port", false, false, true),
+		"ssh_key_enforcement_mode":               utils.GetInt32Attribute("SSH Key Enforcement Mode", false, false, true),
+		"password_rule_id":                       utils.GetInt32Attribute("Password Rule ID", false, false, true),
+		"dss_key_rule_id":                        utils.GetInt32Attribute("DSS Key Rule ID", false, false, true),
+		"login_account_id":                       utils.GetInt32Attribute("Login Account ID", false, false, true),
+		"account_name_format":                    utils.GetInt32Attribute("Account Name Format", false, false, true),
+		"oracle_internet_directory_id":           utils.GetStringAttribute("Oracle Internet Directory ID (GUID)", false, false, true),
+		"oracle_internet_directory_service_name": utils.GetStringAttribute("Oracle Internet Directory Service Name", false, false, true),
+		"release_duration":                       utils.GetInt32Attribute("Release Duration", false, false, true),
+		"max_release_duration":                   utils.GetInt32Attribute("Max Release Duration", false, false, true),
+		"isa_release_duration":                   utils.GetInt32Attribute("ISA Release Duration", false, false, true),
+		"auto_management_flag":                   utils.GetBoolAttribute("Auto Management Flag", false, false, true),
+		"functional_account_id":                  utils.GetInt32Attribute("Functional Account ID", false, false, true),
+		"elevation_command":                      utils.GetStringAttribute("Elevation Command", false, false, true),
+		"check_password_flag":                    utils.GetBoolAttribute("Check Password Flag", false, false, true),
+		"change_password_after_any_release_flag": utils.GetBoolAttribute("Change Password After Any Release Flag", false, false, true),
+		"reset_password_on_mismatch_flag":        utils.GetBoolAttribute("Reset Password On Mismatch Flag", false, false, true),
+		"change_frequency_type":                  utils.GetStringAttribute("Change Frequency Type", false, false, true),
+		"change_frequency_days":                  utils.GetInt32Attribute("Change Frequency Days", false, false, true),
+		"change_time":                            utils.GetStringAttribute("Change Time", false, false, true),
+		"remote_client_type":                     utils.GetStringAttribute("Remote Client Type", false, false, true),
+		"application_host_id":                    utils.GetInt32Attribute("Application Host ID", false, false, true),
+		"is_application_host":                    utils.GetBoolAttribute("Is Application Host", false, false, true),
+		"access_url":                             utils.GetStringAttribute("Access URL", false, false, true),
+	}
+}
+
 func (d *ManagedSystemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 
 	// getting data from Provider
@@ -161,11 +186,15 @@ func (d *ManagedSystemDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	// instantiating managed system obj.
-	managedSystemObj, _ := managed_systems.NewManagedSystem(*d.providerInfo.authenticationObj, zapLogger)
+	filter := utils.ManagedSystemsFilter{
+		SystemName:  data.SystemName.ValueString(),
+		Type:        int(data.Type.ValueInt32()),
+		WorkgroupID: int(data.WorkgroupID.ValueInt32()),
+		PlatformID:  int(data.PlatformID.ValueInt32()),
+	}
 
-	// get managed systems list.
-	items, err := managedSystemObj.GetManagedSystemsListFlow()
+	// get managed systems list, filtered by the API and paginated.
+	items, err := utils.GetManagedSystemsList(*d.providerInfo.authenticationObj, filter, int(data.Limit.ValueInt32()), zapLogger)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting managed systems list", err.Error())
@@ -175,58 +204,63 @@ func (d *ManagedSystemDataSource) Read(ctx context.Context, req datasource.ReadR
 	var managedAccountList []ManagedSystemModel
 
 	for _, item := range items {
-		managedAccountList = append(managedAccountList, ManagedSystemModel{
-			ManagedSystemID:                    types.Int32Value(int32(item.ManagedSystemID)),
-			EntityTypeID:                       types.Int32Value(int32(item.EntityTypeID)),
-			AssetID:                            types.Int32Value(int32(item.AssetID)),
-			DatabaseID:                         types.Int32Value(int32(item.DatabaseID)),
-			DirectoryID:                        types.Int32Value(int32(item.DirectoryID)),
-			CloudID:                            types.Int32Value(int32(item.CloudID)),
-			WorkgroupID:                        types.Int32Value(int32(item.WorkgroupID)),
-			HostName:                           types.StringValue(item.HostName),
-			DNSName:                            types.StringValue(item.DnsName),
-			IPAddress:                          types.StringValue(item.IPAddress),
-			InstanceName:                       types.StringValue(item.InstanceName),
-			IsDefaultInstance:                  types.BoolValue(item.IsDefaultInstance),
-			Template:                           types.StringValue(item.Template),
-			ForestName:                         types.StringValue(item.ForestName),
-			UseSSL:                             types.BoolValue(item.UseSSL),
-			OracleInternetDirectoryID:          types.StringValue(item.OracleInternetDirectoryID),
-			OracleInternetDirectoryServiceName: types.StringValue(item.OracleInternetDirectoryServiceName),
-			SystemName:                         types.StringValue(item.SystemName),
-			PlatformID:                         types.Int32Value(int32(item.PlatformID)),
-			NetBiosName:                        types.StringValue(item.NetBiosName),
-			Port:                               types.Int32Value(int32(item.Port)),
-			Timeout:                            types.Int32Value(int32(item.Timeout)),
-			Description:                        types.StringValue(item.Description),
-			ContactEmail:                       types.StringValue(item.ContactEmail),
-			PasswordRuleID:                     types.Int32Value(int32(item.PasswordRuleID)),
-			DSSKeyRuleID:                       types.Int32Value(int32(item.DSSKeyRuleID)),
-			ReleaseDuration:                    types.Int32Value(int32(item.ReleaseDuration)),
-			MaxReleaseDuration:                 types.Int32Value(int32(item.MaxReleaseDuration)),
-			ISAReleaseDuration:                 types.Int32Value(int32(item.ISAReleaseDuration)),
-			AutoManagementFlag:                 types.BoolValue(item.AutoManagementFlag),
-			FunctionalAccountID:                types.Int32Value(int32(item.FunctionalAccountID)),
-			LoginAccountID:                     types.Int32Value(int32(item.LoginAccountID)),
-			ElevationCommand:                   types.StringValue(item.ElevationCommand),
-			SshKeyEnforcementMode:              types.Int32Value(int32(item.SshKeyEnforcementMode)),
-			CheckPasswordFlag:                  types.BoolValue(item.CheckPasswordFlag),
-			ChangePasswordAfterAnyReleaseFlag:  types.BoolValue(item.ChangePasswordAfterAnyReleaseFlag),
-			ResetPasswordOnMismatchFlag:        types.BoolValue(item.ResetPasswordOnMismatchFlag),
-			ChangeFrequencyType:                types.StringValue(item.ChangeFrequencyType),
-			ChangeFrequencyDays:                types.Int32Value(int32(item.ChangeFrequencyDays)),
-			ChangeTime:                         types.StringValue(item.ChangeTime),
-			AccountNameFormat:                  types.Int32Value(int32(item.AccountNameFormat)),
-			RemoteClientType:                   types.StringValue(item.RemoteClientType),
-			ApplicationHostID:                  types.Int32Value(int32(item.ApplicationHostID)),
-			IsApplicationHost:                  types.BoolValue(item.IsApplicationHost),
-			AccessURL:                          types.StringValue(item.AccessURL),
-		})
+		managedAccountList = append(managedAccountList, newManagedSystemModel(item))
 	}
 
-	responseData := ManagedSystemDataSourceModel{}
+	responseData := data
 	responseData.ManagedSystems = managedAccountList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newManagedSystemModel returns the datasource attributes of a managed system.
+func newManagedSystemModel(item entities.ManagedSystemResponseCreate) ManagedSystemModel {
+	return ManagedSystemModel{
+		ManagedSystemID:                    types.Int32Value(int32(item.ManagedSystemID)),
+		EntityTypeID:                       types.Int32Value(int32(item.EntityTypeID)),
+		AssetID:                            types.Int32Value(int32(item.AssetID)),
+		DatabaseID:                         types.Int32Value(int32(item.DatabaseID)),
+		DirectoryID:                        types.Int32Value(int32(item.DirectoryID)),
+		CloudID:                            types.Int32Value(int32(item.CloudID)),
+		WorkgroupID:                        types.Int32Value(int32(item.WorkgroupID)),
+		HostName:                           types.StringValue(item.HostName),
+		DNSName:                            types.StringValue(item.DnsName),
+		IPAddress:                          types.StringValue(item.IPAddress),
+		InstanceName:                       types.StringValue(item.InstanceName),
+		IsDefaultInstance:                  types.BoolValue(item.IsDefaultInstance),
+		Template:                           types.StringValue(item.Template),
+		ForestName:                         types.StringValue(item.ForestName),
+		UseSSL:                             types.BoolValue(item.UseSSL),
+		OracleInternetDirectoryID:          types.StringValue(item.OracleInternetDirectoryID),
+		OracleInternetDirectoryServiceName: types.StringValue(item.OracleInternetDirectoryServiceName),
+		SystemName:                         types.StringValue(item.SystemName),
+		PlatformID:                         types.Int32Value(int32(item.PlatformID)),
+		NetBiosName:                        types.StringValue(item.NetBiosName),
+		Port:                               types.Int32Value(int32(item.Port)),
+		Timeout:                            types.Int32Value(int32(item.Timeout)),
+		Description:                        types.StringValue(item.Description),
+		ContactEmail:                       types.StringValue(item.ContactEmail),
+		PasswordRuleID:                     types.Int32Value(int32(item.PasswordRuleID)),
+		DSSKeyRuleID:                       types.Int32Value(int32(item.DSSKeyRuleID)),
+		ReleaseDuration:                    types.Int32Value(int32(item.ReleaseDuration)),
+		MaxReleaseDuration:                 types.Int32Value(int32(item.MaxReleaseDuration)),
+		ISAReleaseDuration:                 types.Int32Value(int32(item.ISAReleaseDuration)),
+		AutoManagementFlag:                 types.BoolValue(item.AutoManagementFlag),
+		FunctionalAccountID:                types.Int32Value(int32(item.FunctionalAccountID)),
+		LoginAccountID:                     types.Int32Value(int32(item.LoginAccountID)),
+		ElevationCommand:                   types.StringValue(item.ElevationCommand),
+		SshKeyEnforcementMode:              types.Int32Value(int32(item.SshKeyEnforcementMode)),
+		CheckPasswordFlag:                  types.BoolValue(item.CheckPasswordFlag),
+		ChangePasswordAfterAnyReleaseFlag:  types.BoolValue(item.ChangePasswordAfterAnyReleaseFlag),
+		ResetPasswordOnMismatchFlag:        types.BoolValue(item.ResetPasswordOnMismatchFlag),
+		ChangeFrequencyType:                types.StringValue(item.ChangeFrequencyType),
+		ChangeFrequencyDays:                types.Int32Value(int32(item.ChangeFrequencyDays)),
+		ChangeTime:                         types.StringValue(item.ChangeTime),
+		AccountNameFormat:                  types.Int32Value(int32(item.AccountNameFormat)),
+		RemoteClientType:                   types.StringValue(item.RemoteClientType),
+		ApplicationHostID:                  types.Int32Value(int32(item.ApplicationHostID)),
+		IsApplicationHost:                  types.BoolValue(item.IsApplicationHost),
+		AccessURL:                          types.StringValue(item.AccessURL),
+	}
+}
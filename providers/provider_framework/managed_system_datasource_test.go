@@ -156,3 +156,81 @@ func TestGetManagedSystemsListNotFound(t *testing.T) {
 		},
 	})
 }
+
+func TestGetManagedSystemsListFiltered(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, err := w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, err := w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/ManagedSystems":
+			// filters are sent to the API
+			query := r.URL.Query()
+			if query.Get("type") != "1" || query.Get("limit") == "" {
+				t.Errorf("unexpected query %v", r.URL.RawQuery)
+			}
+
+			_, err := w.Write([]byte(`{"TotalCount": 3, "Data": [ { "ManagedSystemID": 100, "EntityTypeID": 1, "AssetID": 56, "WorkgroupID": 1 },
+				{ "ManagedSystemID": 123, "EntityTypeID": 1, "AssetID": 48, "WorkgroupID": 2 },
+				{ "ManagedSystemID": 124, "EntityTypeID": 1, "AssetID": 49, "WorkgroupID": 1 } ]}`))
+			if err != nil {
+				t.Error(err.Error())
+			}
+
+		case constants.APIPath + "/Auth/Signout":
+			_, err := w.Write([]byte(``))
+			if err != nil {
+				t.Error(err.Error())
+			}
+		}
+
+	}))
+
+	server.URL = server.URL + constants.APIPath
+
+	config := managedSystemsListConfig
+	config.URL = server.URL
+	config.Resource = `
+		data "passwordsafe_managed_system_datasource" "managed_systems_list" {
+			type         = 1
+			workgroup_id = 1
+		}`
+
+	resource.Test(t, resource.TestCase{
+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// test using oauth authentication, get managed systems of the workgroup
+				Config: utils.TestResourceConfig(config),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_managed_system_datasource.managed_systems_list",
+						tfjsonpath.New("managed_systems"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+		},
+	})
+}
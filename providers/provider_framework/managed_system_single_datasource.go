@@ -0,0 +1,81 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &ManagedSystemSingleDataSource{}
+
+// managedSystemFilters are the attributes a managed system is looked up by.
+var managedSystemFilters = []string{"managed_system_id", "system_name", "host_name", "asset_id", "database_id", "platform_id"}
+
+func NewManagedSystemSingleDataSource() datasource.DataSource {
+	return &ManagedSystemSingleDataSource{}
+}
+
+type ManagedSystemSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *ManagedSystemSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_system"
+}
+
+func (d *ManagedSystemSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Managed System Datasource, gets a managed system by ID, name, host name, asset, database or platform.",
+		Attributes:  singleDataSourceAttributes(getManagedSystemDataSourceSchemaAttributes(), managedSystemFilters),
+	}
+}
+
+func (d *ManagedSystemSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(managedSystemFilters)
+}
+
+func (d *ManagedSystemSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ManagedSystemModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := utils.ManagedSystemsFilter{
+		SystemName: data.SystemName.ValueString(),
+		PlatformID: int(data.PlatformID.ValueInt32()),
+	}
+
+	// get managed systems list, filtered by name by the API
+	items, err := utils.GetManagedSystemsList(*d.providerInfo.authenticationObj, filter, 0, zapLogger)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting managed systems list", err.Error())
+		return
+	}
+
+	item, diags := findSingle("managed system", items,
+		int32Filter("managed_system_id", data.ManagedSystemID, func(item entities.ManagedSystemResponseCreate) int { return item.ManagedSystemID }),
+		stringFilter("system_name", data.SystemName, func(item entities.ManagedSystemResponseCreate) string { return item.SystemName }),
+		stringFilter("host_name", data.HostName, func(item entities.ManagedSystemResponseCreate) string { return item.HostName }),
+		int32Filter("asset_id", data.AssetID, func(item entities.ManagedSystemResponseCreate) int { return item.AssetID }),
+		int32Filter("database_id", data.DatabaseID, func(item entities.ManagedSystemResponseCreate) int { return item.DatabaseID }),
+		int32Filter("platform_id", data.PlatformID, func(item entities.ManagedSystemResponseCreate) int { return item.PlatformID }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newManagedSystemModel(item))...)
+
+}
@@ -0,0 +1,99 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var managedSystemSingleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_managed_system" "managed_system" {
+		system_name = "server01"
+	}`,
+}
+
+var managedSystemSingleMultipleConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	data "passwordsafe_managed_system" "managed_system" {
+		platform_id = 2
+	}`,
+}
+
+func TestGetManagedSystem(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/ManagedSystems":
+			response = `[ { "ManagedSystemID": 100, "AssetID": 56, "PlatformID": 2, "SystemName": "server01" }, { "ManagedSystemID": 123, "AssetID": 48, "PlatformID": 2, "SystemName": "server02" } ]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	managedSystemSingleConfig.URL = server.URL
+	managedSystemSingleMultipleConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				// test getting the only object matching the filters
+				Config: utils.TestResourceConfig(managedSystemSingleConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_managed_system.managed_system",
+						tfjsonpath.New("managed_system_id"),
+						knownvalue.Int32Exact(100),
+					),
+				},
+			},
+			{
+				// test filters matching more than one object
+				Config:      utils.TestResourceConfig(managedSystemSingleMultipleConfig),
+				ExpectError: regexp.MustCompile("Multiple managed systems found"),
+			},
+		},
+	})
+}
@@ -10,7 +10,6 @@ import (
 	"maps"
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -19,6 +18,7 @@ import (
 
 var _ resource.Resource = &managedSystemResource{}
 var _ resource.ResourceWithImportState = &managedSystemResource{}
+var _ resource.ResourceWithIdentity = &managedSystemResource{}
 
 func NewManagedSytemByAssetResource() resource.Resource {
 	return &managedSystemResource{}
@@ -220,10 +220,12 @@ func (r *managedSystemResource) Create(ctx context.Context, req resource.CreateR
 	data.ManagedSystemName = types.StringValue(createdDataBase.SystemName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "managed_system_id", data.ManagedSystemID)...)
 }
 
 func (r *managedSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "managed_system_id", req, resp)
 }
 
 func (r *managedSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -247,6 +249,10 @@ func (r *managedSystemResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+func (r *managedSystemResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("managed_system_id", "Managed System Id")
+}
+
 func (r *managedSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "managed_system_id", req, resp)
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	managed_systems "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_systems"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +17,7 @@ import (
 
 var _ resource.Resource = &managedSystemByDatabaseResource{}
 var _ resource.ResourceWithImportState = &managedSystemByDatabaseResource{}
+var _ resource.ResourceWithIdentity = &managedSystemByDatabaseResource{}
 
 func NewManagedSytemByDatabaseResource() resource.Resource {
 	return &managedSystemByDatabaseResource{}
@@ -131,10 +131,12 @@ func (r *managedSystemByDatabaseResource) Create(ctx context.Context, req resour
 	data.ManagedSystemName = types.StringValue(createdDataBase.SystemName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "managed_system_id", data.ManagedSystemID)...)
 }
 
 func (r *managedSystemByDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "managed_system_id", req, resp)
 }
 
 func (r *managedSystemByDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -158,8 +160,12 @@ func (r *managedSystemByDatabaseResource) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *managedSystemByDatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("managed_system_id", "Managed System Id")
+}
+
 func (r *managedSystemByDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "managed_system_id", req, resp)
 }
 
 // getManagedSystemObj get managedSystemObj for create manage system by asset, workgroup, database.
@@ -9,7 +9,6 @@ import (
 	"terraform-provider-passwordsafe/providers/utils"
 
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -18,6 +17,7 @@ import (
 
 var _ resource.Resource = &managedSystemByWorkGroupResource{}
 var _ resource.ResourceWithImportState = &managedSystemByWorkGroupResource{}
+var _ resource.ResourceWithIdentity = &managedSystemByWorkGroupResource{}
 
 func NewManagedSytemByWorkGroupResource() resource.Resource {
 	return &managedSystemByWorkGroupResource{}
@@ -310,10 +310,12 @@ func (r *managedSystemByWorkGroupResource) Create(ctx context.Context, req resou
 	data.ManagedSystemName = types.StringValue(createdDataBase.SystemName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, "managed_system_id", data.ManagedSystemID)...)
 }
 
 func (r *managedSystemByWorkGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// method not implemented
+	// method not implemented, only the identity is set
+	readInt32Identity(ctx, "managed_system_id", req, resp)
 }
 
 func (r *managedSystemByWorkGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -337,6 +339,10 @@ func (r *managedSystemByWorkGroupResource) Delete(ctx context.Context, req resou
 	}
 }
 
+func (r *managedSystemByWorkGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = int32IdentitySchema("managed_system_id", "Managed System Id")
+}
+
 func (r *managedSystemByWorkGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByInt32ID(ctx, "managed_system_id", req, resp)
 }
@@ -0,0 +1,240 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_systems"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &managedSystemListResource{}
+
+// NewManagedSystemByAssetListResource lists the managed systems of assets.
+func NewManagedSystemByAssetListResource() list.ListResource {
+	return &managedSystemListResource{
+		resourceName: "_managed_system_by_asset",
+		description:  "Managed system list resource, lists the managed systems of assets.",
+		isOfKind: func(item entities.ManagedSystemResponseCreate) bool {
+			return item.AssetID != 0 && item.DatabaseID == 0
+		},
+		resourceModel: func(item entities.ManagedSystemResponseCreate) any {
+			return ManagedSystemResourceModel{
+				AssetId:                           types.StringValue(strconv.Itoa(item.AssetID)),
+				ManagedSystemID:                   types.Int32Value(int32(item.ManagedSystemID)),
+				ManagedSystemName:                 types.StringValue(item.SystemName),
+				PlatformID:                        types.Int32Value(int32(item.PlatformID)),
+				ContactEmail:                      types.StringValue(item.ContactEmail),
+				Description:                       types.StringValue(item.Description),
+				Port:                              types.Int32Value(int32(item.Port)),
+				Timeout:                           types.Int32Value(int32(item.Timeout)),
+				SshKeyEnforcementMode:             types.Int32Value(int32(item.SshKeyEnforcementMode)),
+				PasswordRuleID:                    types.Int32Value(int32(item.PasswordRuleID)),
+				DSSKeyRuleID:                      types.Int32Value(int32(item.DSSKeyRuleID)),
+				LoginAccountID:                    types.Int32Value(int32(item.LoginAccountID)),
+				ReleaseDuration:                   types.Int32Value(int32(item.ReleaseDuration)),
+				MaxReleaseDuration:                types.Int32Value(int32(item.MaxReleaseDuration)),
+				ISAReleaseDuration:                types.Int32Value(int32(item.ISAReleaseDuration)),
+				AutoManagementFlag:                types.BoolValue(item.AutoManagementFlag),
+				FunctionalAccountID:               types.Int32Value(int32(item.FunctionalAccountID)),
+				ElevationCommand:                  types.StringValue(item.ElevationCommand),
+				CheckPasswordFlag:                 types.BoolValue(item.CheckPasswordFlag),
+				ChangePasswordAfterAnyReleaseFlag: types.BoolValue(item.ChangePasswordAfterAnyReleaseFlag),
+				ResetPasswordOnMismatchFlag:       types.BoolValue(item.ResetPasswordOnMismatchFlag),
+				ChangeFrequencyType:               types.StringValue(item.ChangeFrequencyType),
+				ChangeFrequencyDays:               types.Int32Value(int32(item.ChangeFrequencyDays)),
+				ChangeTime:                        types.StringValue(item.ChangeTime),
+				RemoteClientType:                  types.StringValue(item.RemoteClientType),
+				ApplicationHostID:                 types.Int32Value(int32(item.ApplicationHostID)),
+				IsApplicationHost:                 types.BoolValue(item.IsApplicationHost),
+			}
+		},
+	}
+}
+
+// NewManagedSystemByDatabaseListResource lists the managed systems of databases.
+func NewManagedSystemByDatabaseListResource() list.ListResource {
+	return &managedSystemListResource{
+		resourceName: "_managed_system_by_database",
+		description:  "Managed system list resource, lists the managed systems of databases.",
+		isOfKind: func(item entities.ManagedSystemResponseCreate) bool {
+			return item.DatabaseID != 0
+		},
+		resourceModel: func(item entities.ManagedSystemResponseCreate) any {
+			return ManagedSystemByDataBaseResourceModel{
+				DatabaseId:                        types.StringValue(strconv.Itoa(item.DatabaseID)),
+				ManagedSystemID:                   types.Int32Value(int32(item.ManagedSystemID)),
+				ManagedSystemName:                 types.StringValue(item.SystemName),
+				ContactEmail:                      types.StringValue(item.ContactEmail),
+				Description:                       types.StringValue(item.Description),
+				Timeout:                           types.Int32Value(int32(item.Timeout)),
+				PasswordRuleID:                    types.Int32Value(int32(item.PasswordRuleID)),
+				ReleaseDuration:                   types.Int32Value(int32(item.ReleaseDuration)),
+				MaxReleaseDuration:                types.Int32Value(int32(item.MaxReleaseDuration)),
+				ISAReleaseDuration:                types.Int32Value(int32(item.ISAReleaseDuration)),
+				AutoManagementFlag:                types.BoolValue(item.AutoManagementFlag),
+				FunctionalAccountID:               types.Int32Value(int32(item.FunctionalAccountID)),
+				CheckPasswordFlag:                 types.BoolValue(item.CheckPasswordFlag),
+				ChangePasswordAfterAnyReleaseFlag: types.BoolValue(item.ChangePasswordAfterAnyReleaseFlag),
+				ResetPasswordOnMismatchFlag:       types.BoolValue(item.ResetPasswordOnMismatchFlag),
+				ChangeFrequencyType:               types.StringValue(item.ChangeFrequencyType),
+				ChangeFrequencyDays:               types.Int32Value(int32(item.ChangeFrequencyDays)),
+				ChangeTime:                        types.StringValue(item.ChangeTime),
+			}
+		},
+	}
+}
+
+// NewManagedSystemByWorkGroupListResource lists the managed systems that are neither of an asset nor of a database,
+// such as directories, cloud platforms and applications.
+func NewManagedSystemByWorkGroupListResource() list.ListResource {
+	return &managedSystemListResource{
+		resourceName: "_managed_system_by_workgroup",
+		description:  "Managed system list resource, lists the managed systems that are neither of an asset nor of a database, such as directories, cloud platforms and applications.",
+		isOfKind: func(item entities.ManagedSystemResponseCreate) bool {
+			return item.AssetID == 0 && item.DatabaseID == 0
+		},
+		resourceModel: func(item entities.ManagedSystemResponseCreate) any {
+			return ManagedSystemByWorkGroupResourceModel{
+				WorkgroupId:                        types.StringValue(strconv.Itoa(item.WorkgroupID)),
+				ManagedSystemID:                    types.Int32Value(int32(item.ManagedSystemID)),
+				ManagedSystemName:                  types.StringValue(item.SystemName),
+				EntityTypeID:                       types.Int32Value(int32(item.EntityTypeID)),
+				HostName:                           types.StringValue(item.HostName),
+				IPAddress:                          types.StringValue(item.IPAddress),
+				DnsName:                            types.StringValue(item.DnsName),
+				InstanceName:                       types.StringValue(item.InstanceName),
+				IsDefaultInstance:                  types.BoolValue(item.IsDefaultInstance),
+				Template:                           types.StringValue(item.Template),
+				ForestName:                         types.StringValue(item.ForestName),
+				UseSSL:                             types.BoolValue(item.UseSSL),
+				PlatformID:                         types.Int32Value(int32(item.PlatformID)),
+				NetBiosName:                        types.StringValue(item.NetBiosName),
+				ContactEmail:                       types.StringValue(item.ContactEmail),
+				Description:                        types.StringValue(item.Description),
+				Port:                               types.Int32Value(int32(item.Port)),
+				Timeout:                            types.Int32Value(int32(item.Timeout)),
+				SshKeyEnforcementMode:              types.Int32Value(int32(item.SshKeyEnforcementMode)),
+				PasswordRuleID:                     types.Int32Value(int32(item.PasswordRuleID)),
+				DSSKeyRuleID:                       types.Int32Value(int32(item.DSSKeyRuleID)),
+				LoginAccountID:                     types.Int32Value(int32(item.LoginAccountID)),
+				AccountNameFormat:                  types.Int32Value(int32(item.AccountNameFormat)),
+				OracleInternetDirectoryID:          types.StringValue(item.OracleInternetDirectoryID),
+				OracleInternetDirectoryServiceName: types.StringValue(item.OracleInternetDirectoryServiceName),
+				ReleaseDuration:                    types.Int32Value(int32(item.ReleaseDuration)),
+				MaxReleaseDuration:                 types.Int32Value(int32(item.MaxReleaseDuration)),
+				ISAReleaseDuration:                 types.Int32Value(int32(item.ISAReleaseDuration)),
+				AutoManagementFlag:                 types.BoolValue(item.AutoManagementFlag),
+				FunctionalAccountID:                types.Int32Value(int32(item.FunctionalAccountID)),
+				ElevationCommand:                   types.StringValue(item.ElevationCommand),
+				CheckPasswordFlag:                  types.BoolValue(item.CheckPasswordFlag),
+				ChangePasswordAfterAnyReleaseFlag:  types.BoolValue(item.ChangePasswordAfterAnyReleaseFlag),
+				ResetPasswordOnMismatchFlag:        types.BoolValue(item.ResetPasswordOnMismatchFlag),
+				ChangeFrequencyType:                types.StringValue(item.ChangeFrequencyType),
+				ChangeFrequencyDays:                types.Int32Value(int32(item.ChangeFrequencyDays)),
+				ChangeTime:                         types.StringValue(item.ChangeTime),
+				AccessURL:                          types.StringValue(item.AccessURL),
+				RemoteClientType:                   types.StringValue(item.RemoteClientType),
+				ApplicationHostID:                  types.Int32Value(int32(item.ApplicationHostID)),
+				IsApplicationHost:                  types.BoolValue(item.IsApplicationHost),
+			}
+		},
+	}
+}
+
+// managedSystemListResource lists the managed systems of one kind, isOfKind selects the managed systems of the kind
+// and resourceModel returns their resource attributes.
+type managedSystemListResource struct {
+	listResource
+	resourceName  string
+	description   string
+	isOfKind      func(item entities.ManagedSystemResponseCreate) bool
+	resourceModel func(item entities.ManagedSystemResponseCreate) any
+}
+
+type ManagedSystemListResourceModel struct {
+	WorkgroupId types.String `tfsdk:"workgroup_id"`
+	PlatformID  types.Int32  `tfsdk:"platform_id"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+func (l *managedSystemListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + l.resourceName
+}
+
+func (l *managedSystemListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: l.description,
+		Attributes: map[string]schema.Attribute{
+			"workgroup_id": schema.StringAttribute{
+				Description: "Lists only the managed systems of the workgroup.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"platform_id":  platformIDAttribute("Lists only the managed systems of the platform."),
+			"name_pattern": namePatternAttribute("Managed system name pattern."),
+		},
+	}
+}
+
+func (l *managedSystemListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ManagedSystemListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	managedSystemObj, err := managed_systems.NewManagedSystem(*l.providerInfo.authenticationObj, zapLogger)
+	if err != nil {
+		diags.AddError("Error creating managed system object", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := managedSystemObj.GetManagedSystemsListFlow()
+	if err != nil {
+		diags.AddError("Error getting managed systems list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pattern := utils.NewNamePattern(data.NamePattern.ValueString())
+
+	var filtered []entities.ManagedSystemResponseCreate
+	for _, item := range items {
+		if !l.isOfKind(item) {
+			continue
+		}
+		if !data.WorkgroupId.IsNull() && strconv.Itoa(item.WorkgroupID) != data.WorkgroupId.ValueString() {
+			continue
+		}
+		if !data.PlatformID.IsNull() && int32(item.PlatformID) != data.PlatformID.ValueInt32() {
+			continue
+		}
+		if pattern.Match(item.SystemName) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	streamListResults(ctx, req, stream, filtered, func(item entities.ManagedSystemResponseCreate, result *list.ListResult) {
+		result.DisplayName = item.SystemName
+		result.Diagnostics.Append(setInt32Identity(ctx, result.Identity, "managed_system_id", types.Int32Value(int32(item.ManagedSystemID)))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, l.resourceModel(item))...)
+		}
+	})
+}
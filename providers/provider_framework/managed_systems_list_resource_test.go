@@ -0,0 +1,107 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var ManagedSystemListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_managed_system_by_asset" "servers" {
+	provider = passwordsafe
+	config {
+		workgroup_id = "1"
+		name_pattern = "server*"
+	}
+	}
+
+	list "passwordsafe_managed_system_by_database" "databases" {
+	provider = passwordsafe
+	config {
+	}
+	}
+
+	list "passwordsafe_managed_system_by_workgroup" "directories" {
+	provider = passwordsafe
+	config {
+		platform_id = 25
+	}
+	}`,
+}
+
+func TestManagedSystemListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/ManagedSystems":
+			response = `[
+				{"ManagedSystemID":1,"AssetID":10,"WorkgroupID":1,"PlatformID":1,"SystemName":"server01"},
+				{"ManagedSystemID":2,"AssetID":11,"WorkgroupID":2,"PlatformID":1,"SystemName":"server02"},
+				{"ManagedSystemID":3,"AssetID":10,"DatabaseID":5,"WorkgroupID":1,"PlatformID":11,"SystemName":"server01/primary"},
+				{"ManagedSystemID":4,"DirectoryID":2,"WorkgroupID":1,"PlatformID":25,"SystemName":"example.com"}
+			]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	ManagedSystemListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(ManagedSystemListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_managed_system_by_asset.servers", 1),
+					querycheck.ExpectIdentity("passwordsafe_managed_system_by_asset.servers", map[string]knownvalue.Check{
+						"managed_system_id": knownvalue.Int32Exact(1),
+					}),
+					querycheck.ExpectLength("passwordsafe_managed_system_by_database.databases", 1),
+					querycheck.ExpectIdentity("passwordsafe_managed_system_by_database.databases", map[string]knownvalue.Check{
+						"managed_system_id": knownvalue.Int32Exact(3),
+					}),
+					querycheck.ExpectLength("passwordsafe_managed_system_by_workgroup.directories", 1),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"crypto/rand"
+	"strconv"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralPassword{}
+
+// @EphemeralResource(passwordsafe_password_ephemeral, name="Password")
+func NewEphemeralPassword() ephemeral.EphemeralResource {
+	return &EphemeralPassword{}
+}
+
+type EphemeralPassword struct {
+	providerInfo *ProviderData
+}
+
+type EphemeralPasswordModel struct {
+	PasswordRuleID     types.Int32  `tfsdk:"password_rule_id"`
+	MinLength          types.Int32  `tfsdk:"min_length"`
+	MaxLength          types.Int32  `tfsdk:"max_length"`
+	Lowercase          types.String `tfsdk:"lowercase"`
+	Uppercase          types.String `tfsdk:"uppercase"`
+	Numeric            types.String `tfsdk:"numeric"`
+	Symbols            types.String `tfsdk:"symbols"`
+	MinLowercase       types.Int32  `tfsdk:"min_lowercase"`
+	MinUppercase       types.Int32  `tfsdk:"min_uppercase"`
+	MinNumeric         types.Int32  `tfsdk:"min_numeric"`
+	MinSymbols         types.Int32  `tfsdk:"min_symbols"`
+	AllowedSymbols     types.String `tfsdk:"allowed_symbols"`
+	FirstCharacter     types.String `tfsdk:"first_character"`
+	ExcludedCharacters types.String `tfsdk:"excluded_characters"`
+	Value              types.String `tfsdk:"value"`
+}
+
+func (e *EphemeralPassword) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_password_ephemeral"
+}
+
+func (e *EphemeralPassword) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	conflictsWithRule := path.MatchRoot("password_rule_id")
+
+	lengthAttribute := func(description string, minimum int32) schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.Int32{
+				int32validator.Between(minimum, 256),
+				int32validator.ConflictsWith(conflictsWithRule),
+			},
+		}
+	}
+	requirementAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(utils.CharacterRequirements...),
+				stringvalidator.ConflictsWith(conflictsWithRule),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+
+		MarkdownDescription: "Password Ephemeral Resource, generates a password complying with a Password Safe password rule, " +
+			"either the rule with password_rule_id or the rule set by the other attributes. " + passwordRuleDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"password_rule_id": schema.Int32Attribute{
+				Description: "ID of the password rule to comply with, only excluded_characters can be used together with it.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"min_length":    lengthAttribute("Minimum password length", 1),
+			"max_length":    lengthAttribute("Maximum password length", 1),
+			"lowercase":     requirementAttribute("Lowercase characters requirement"),
+			"uppercase":     requirementAttribute("Uppercase characters requirement"),
+			"numeric":       requirementAttribute("Numeric characters requirement"),
+			"symbols":       requirementAttribute("Symbols requirement"),
+			"min_lowercase": lengthAttribute("Minimum number of lowercase characters when they are required", 0),
+			"min_uppercase": lengthAttribute("Minimum number of uppercase characters when they are required", 0),
+			"min_numeric":   lengthAttribute("Minimum number of numeric characters when they are required", 0),
+			"min_symbols":   lengthAttribute("Minimum number of symbols when they are required", 0),
+			"allowed_symbols": schema.StringAttribute{
+				Description: "Symbols that can be used",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(conflictsWithRule),
+				},
+			},
+			"first_character": schema.StringAttribute{
+				Description: "First character rule",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.FirstCharacterRules...),
+					stringvalidator.ConflictsWith(conflictsWithRule),
+				},
+			},
+			"excluded_characters": schema.StringAttribute{
+				Description: "Characters that are never used",
+				Optional:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Generated password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *EphemeralPassword) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	e.providerInfo = &c
+
+	if e.providerInfo.userName == "" {
+		return
+	}
+
+}
+
+func (e *EphemeralPassword) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+
+	var data EphemeralPasswordModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := e.getPasswordRule(data)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting password rule", err.Error())
+		return
+	}
+
+	password, err := utils.GeneratePassword(rule, rand.Reader)
+	if err != nil {
+		response.Diagnostics.AddError("Error generating password", err.Error())
+		return
+	}
+
+	data.Value = types.StringValue(password)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+
+}
+
+// getPasswordRule gets the rule with password_rule_id from Password Safe, or builds the rule of the other attributes.
+func (e *EphemeralPassword) getPasswordRule(data EphemeralPasswordModel) (utils.PasswordRule, error) {
+	if data.PasswordRuleID.IsNull() {
+		return utils.ParsePasswordRule(data.constraints())
+	}
+
+	apiRule, err := utils.GetPasswordRule(*e.providerInfo.authenticationObj, int(data.PasswordRuleID.ValueInt32()), zapLogger)
+	if err != nil {
+		return utils.PasswordRule{}, err
+	}
+
+	rule := utils.NewPasswordRuleFromAPI(apiRule)
+	rule.ExcludedCharacters = data.ExcludedCharacters.ValueString()
+	return rule, nil
+}
+
+// constraints returns the rule constraints set in the configuration, as accepted by utils.ParsePasswordRule.
+func (data EphemeralPasswordModel) constraints() map[string]string {
+	constraints := map[string]string{}
+	for name, value := range map[string]types.Int32{
+		"min_length":    data.MinLength,
+		"max_length":    data.MaxLength,
+		"min_lowercase": data.MinLowercase,
+		"min_uppercase": data.MinUppercase,
+		"min_numeric":   data.MinNumeric,
+		"min_symbols":   data.MinSymbols,
+	} {
+		if !value.IsNull() {
+			constraints[name] = strconv.Itoa(int(value.ValueInt32()))
+		}
+	}
+
+	for name, value := range map[string]types.String{
+		"lowercase":           data.Lowercase,
+		"uppercase":           data.Uppercase,
+		"numeric":             data.Numeric,
+		"symbols":             data.Symbols,
+		"allowed_symbols":     data.AllowedSymbols,
+		"first_character":     data.FirstCharacter,
+		"excluded_characters": data.ExcludedCharacters,
+	} {
+		if !value.IsNull() {
+			constraints[name] = value.ValueString()
+		}
+	}
+	return constraints
+}
@@ -0,0 +1,92 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var PasswordEphemeralConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	ephemeral "passwordsafe_password_ephemeral" "test" {
+	password_rule_id = 7
+	excluded_characters = "0Oo1lI"
+	}
+
+	provider "echo" {
+	data = ephemeral.passwordsafe_password_ephemeral.test
+	}
+
+	resource "echo" "test" {}`,
+}
+
+func newPasswordRuleMockServer(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/PasswordRules/7":
+			_, _ = w.Write([]byte(`{"PasswordRuleID": 7, "Name": "Database", "MinimumLength": 20, "MaximumLength": 20, "FirstCharacterRequirement": "C",
+				"LowercaseRequirement": "R", "UppercaseRequirement": "R", "NumericRequirement": "R", "SymbolRequirement": "N"}`))
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestEphemeralPassword(t *testing.T) {
+
+	server := newPasswordRuleMockServer(t)
+
+	server.URL = server.URL + constants.APIPath
+	PasswordEphemeralConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: utils.TestResourceConfig(PasswordEphemeralConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("value"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{19}$`)),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GeneratePasswordFunction generates a password complying with the constraints of a password rule.
+type GeneratePasswordFunction struct{}
+
+var _ function.Function = &GeneratePasswordFunction{}
+
+// NewGeneratePasswordFunction returns the generate_password function.
+func NewGeneratePasswordFunction() function.Function {
+	return &GeneratePasswordFunction{}
+}
+
+// passwordRuleDescription describes the password rule constraints shared by generate_password and passwordsafe_password_ephemeral.
+var passwordRuleDescription = fmt.Sprintf("min_length and max_length set the length range (default: %v). lowercase, uppercase, numeric and symbols are one of %v, "+
+	"min_lowercase, min_uppercase, min_numeric and min_symbols set how many characters of a required class the password contains (default: 1). "+
+	"allowed_symbols lists the symbols that can be used (default: %v), first_character is one of %v and excluded_characters lists characters that are never used.",
+	utils.DefaultPasswordLength, strings.Join(utils.CharacterRequirements, ", "), utils.DefaultPasswordSymbols, strings.Join(utils.FirstCharacterRules, ", "))
+
+func (f *GeneratePasswordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "generate_password"
+}
+
+func (f *GeneratePasswordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generates a password complying with a Password Safe password rule.",
+		MarkdownDescription: "Generates a password complying with the constraints of rule. Provider functions must return the same value on every run, " +
+			"so the password is derived from seed and only changes when seed or rule change. Use a secret seed, for example the result of a random_bytes resource.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:           "rule",
+				Description:    "Password rule constraints, null uses the default rule. " + passwordRuleDescription,
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:        "seed",
+				Description: "Secret value the password is derived from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GeneratePasswordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ruleValue types.Map
+	var seed string
+
+	resp.Error = req.Arguments.Get(ctx, &ruleValue, &seed)
+	if resp.Error != nil {
+		return
+	}
+
+	constraints := map[string]string{}
+	if diags := ruleValue.ElementsAs(ctx, &constraints, false); diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	rule, err := utils.ParsePasswordRule(constraints)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	random, err := utils.NewSeededReader(seed)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	password, err := utils.GeneratePassword(rule, random)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, password)
+}
@@ -0,0 +1,39 @@
+package provider_framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGeneratePasswordFunction(t *testing.T) {
+	rule := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"min_length":      types.StringValue("24"),
+		"max_length":      types.StringValue("24"),
+		"symbols":         types.StringValue("required"),
+		"allowed_symbols": types.StringValue("!@#"),
+	})
+
+	first := runFunction(t, NewGeneratePasswordFunction(), rule, types.StringValue("seed"))
+	second := runFunction(t, NewGeneratePasswordFunction(), rule, types.StringValue("seed"))
+	if first.Error != nil || second.Error != nil {
+		t.Fatalf("generate_password: %v, %v", first.Error, second.Error)
+	}
+
+	password, ok := first.Result.Value().(types.String)
+	if !ok || len(password.ValueString()) != 24 || !first.Result.Value().Equal(second.Result.Value()) {
+		t.Errorf("expected the same 24 characters password for the same seed, got %v and %v", first.Result.Value(), second.Result.Value())
+	}
+
+	resp := runFunction(t, NewGeneratePasswordFunction(), types.MapNull(types.StringType), types.StringValue("seed"))
+	if resp.Error != nil {
+		t.Errorf("generate_password with the default rule: %v", resp.Error)
+	}
+
+	invalidRule := types.MapValueMust(types.StringType, map[string]attr.Value{"length": types.StringValue("16")})
+	resp = runFunction(t, NewGeneratePasswordFunction(), invalidRule, types.StringValue("seed"))
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected an error on the rule, got %v", resp.Error)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// separatorParameter is the optional last argument of the path functions.
+var separatorParameter = function.StringParameter{
+	Name:        "separator",
+	Description: "Separator between the path elements, defaults to /. At most one separator can be passed.",
+}
+
+// pathFunction joins two path elements, as secret_path and managed_account_path do.
+type pathFunction struct {
+	name        string
+	summary     string
+	description string
+	parameters  [2]function.StringParameter
+	join        func(string, string, string) (string, error)
+}
+
+// parsePathFunction splits a path into two elements, as parse_secret_path and parse_managed_account_path do.
+type parsePathFunction struct {
+	name        string
+	summary     string
+	description string
+	parameter   function.StringParameter
+	attributes  [2]string
+	parse       func(string, string) (string, string, error)
+}
+
+// NormalizeFolderPathFunction normalizes a folder path.
+type NormalizeFolderPathFunction struct{}
+
+var (
+	_ function.Function = &pathFunction{}
+	_ function.Function = &parsePathFunction{}
+	_ function.Function = &NormalizeFolderPathFunction{}
+)
+
+// NewSecretPathFunction returns the secret_path function.
+func NewSecretPathFunction() function.Function {
+	return &pathFunction{
+		name:        "secret_path",
+		summary:     "Builds a secret path from a folder path and a secret title.",
+		description: "Joins folder_path and title with the separator used by the secret lookups. The folder path is normalized and the title cannot contain the separator.",
+		parameters: [2]function.StringParameter{
+			{Name: "folder_path", Description: "Folder path of the secret."},
+			{Name: "title", Description: "Secret title."},
+		},
+		join: utils.SecretPath,
+	}
+}
+
+// NewManagedAccountPathFunction returns the managed_account_path function.
+func NewManagedAccountPathFunction() function.Function {
+	return &pathFunction{
+		name:        "managed_account_path",
+		summary:     "Builds a managed account path from a system name and an account name.",
+		description: "Joins system_name and account_name with the separator used by the managed account lookups. Neither name can contain the separator.",
+		parameters: [2]function.StringParameter{
+			{Name: "system_name", Description: "Managed system name."},
+			{Name: "account_name", Description: "Managed account name."},
+		},
+		join: utils.ManagedAccountPath,
+	}
+}
+
+// NewParseSecretPathFunction returns the parse_secret_path function.
+func NewParseSecretPathFunction() function.Function {
+	return &parsePathFunction{
+		name:        "parse_secret_path",
+		summary:     "Splits a secret path into its folder path and secret title.",
+		description: "Returns an object with the folder path and the title of secret_path, the title is the last element of the path.",
+		parameter:   function.StringParameter{Name: "secret_path", Description: "Secret path, folder path and title joined by the separator."},
+		attributes:  [2]string{"path", "title"},
+		parse:       utils.ParseSecretPath,
+	}
+}
+
+// NewParseManagedAccountPathFunction returns the parse_managed_account_path function.
+func NewParseManagedAccountPathFunction() function.Function {
+	return &parsePathFunction{
+		name:        "parse_managed_account_path",
+		summary:     "Splits a managed account path into its system name and account name.",
+		description: "Returns an object with the system name and the account name of managed_account_path.",
+		parameter:   function.StringParameter{Name: "managed_account_path", Description: "Managed account path, system name and account name joined by the separator."},
+		attributes:  [2]string{"system_name", "account_name"},
+		parse:       utils.ParseManagedAccountPath,
+	}
+}
+
+// NewNormalizeFolderPathFunction returns the normalize_folder_path function.
+func NewNormalizeFolderPathFunction() function.Function {
+	return &NormalizeFolderPathFunction{}
+}
+
+func (f *pathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *pathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             f.summary,
+		MarkdownDescription: f.description,
+		Parameters:          []function.Parameter{f.parameters[0], f.parameters[1]},
+		VariadicParameter:   separatorParameter,
+		Return:              function.StringReturn{},
+	}
+}
+
+func (f *pathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var first, second string
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &first, &second, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	separator, funcErr := getSeparatorArgument(separators, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	path, err := f.join(first, second, separator)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, path)
+}
+
+func (f *parsePathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *parsePathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             f.summary,
+		MarkdownDescription: f.description,
+		Parameters:          []function.Parameter{f.parameter},
+		VariadicParameter:   separatorParameter,
+		Return:              function.ObjectReturn{AttributeTypes: f.attributeTypes()},
+	}
+}
+
+func (f *parsePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &value, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	separator, funcErr := getSeparatorArgument(separators, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	first, second, err := f.parse(value, separator)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(f.attributeTypes(), map[string]attr.Value{
+		f.attributes[0]: types.StringValue(first),
+		f.attributes[1]: types.StringValue(second),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func (f *parsePathFunction) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		f.attributes[0]: types.StringType,
+		f.attributes[1]: types.StringType,
+	}
+}
+
+func (f *NormalizeFolderPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_folder_path"
+}
+
+func (f *NormalizeFolderPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalizes a folder path.",
+		MarkdownDescription: "Trims the whitespace around every folder and removes leading, trailing and duplicate separators, the way Password Safe stores folder paths.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "folder_path", Description: "Folder path to normalize."},
+		},
+		VariadicParameter: separatorParameter,
+		Return:            function.StringReturn{},
+	}
+}
+
+func (f *NormalizeFolderPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var folderPath string
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &folderPath, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	separator, funcErr := getSeparatorArgument(separators, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	normalized, err := utils.NormalizeFolderPath(folderPath, separator)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
+
+// getSeparatorArgument returns the optional separator argument, position is the index of the variadic argument.
+func getSeparatorArgument(separators []string, position int64) (string, *function.FuncError) {
+	switch len(separators) {
+	case 0:
+		return utils.DefaultSeparator, nil
+	case 1:
+		return separators[0], nil
+	}
+	return "", function.NewArgumentFuncError(position+1, fmt.Sprintf("at most one separator can be passed, got %v", len(separators)))
+}
@@ -0,0 +1,70 @@
+package provider_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction runs a provider function with the given arguments, the last argument is the variadic separator list.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	var definition function.DefinitionResponse
+	f.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+	if objectReturn, ok := definition.Definition.Return.(function.ObjectReturn); ok {
+		resp.Result = function.NewResultData(types.ObjectUnknown(objectReturn.AttributeTypes))
+	}
+
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+	return resp
+}
+
+func separators(values ...string) types.Tuple {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(value)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func TestSecretPathFunction(t *testing.T) {
+	resp := runFunction(t, NewSecretPathFunction(), types.StringValue("oauthgrp//folder1"), types.StringValue("title"), separators())
+	if resp.Error != nil || !resp.Result.Value().Equal(types.StringValue("oauthgrp/folder1/title")) {
+		t.Errorf("secret_path = %v, %v", resp.Result.Value(), resp.Error)
+	}
+
+	resp = runFunction(t, NewSecretPathFunction(), types.StringValue("oauthgrp"), types.StringValue("title"), separators("-", "|"))
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 3 {
+		t.Errorf("expected an error on the second separator, got %v", resp.Error)
+	}
+}
+
+func TestParseManagedAccountPathFunction(t *testing.T) {
+	resp := runFunction(t, NewParseManagedAccountPathFunction(), types.StringValue("system01|managed_account01"), separators("|"))
+	expected := types.ObjectValueMust(
+		map[string]attr.Type{"system_name": types.StringType, "account_name": types.StringType},
+		map[string]attr.Value{"system_name": types.StringValue("system01"), "account_name": types.StringValue("managed_account01")},
+	)
+	if resp.Error != nil || !resp.Result.Value().Equal(expected) {
+		t.Errorf("parse_managed_account_path = %v, %v", resp.Result.Value(), resp.Error)
+	}
+
+	resp = runFunction(t, NewParseManagedAccountPathFunction(), types.StringValue("system01"), separators())
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected an error on the managed account path, got %v", resp.Error)
+	}
+}
+
+func TestNormalizeFolderPathFunction(t *testing.T) {
+	resp := runFunction(t, NewNormalizeFolderPathFunction(), types.StringValue(" /oauthgrp// folder1 / "), separators())
+	if resp.Error != nil || !resp.Result.Value().Equal(types.StringValue("oauthgrp/folder1")) {
+		t.Errorf("normalize_folder_path = %v, %v", resp.Result.Value(), resp.Error)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/platforms"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &PlatformSingleDataSource{}
+
+// platformFilters are the attributes a platform is looked up by.
+var platformFilters = []string{"platform_id", "name", "short_name"}
+
+func NewPlatformSingleDataSource() datasource.DataSource {
+	return &PlatformSingleDataSource{}
+}
+
+type PlatformSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *PlatformSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform"
+}
+
+func (d *PlatformSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Platform Datasource, gets a platform by ID, name or short name.",
+		Attributes:  singleDataSourceAttributes(getPlatformDataSourceSchemaAttributes(), platformFilters),
+	}
+}
+
+func (d *PlatformSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(platformFilters)
+}
+
+func (d *PlatformSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// instantiating platform obj
+	platformObj, _ := platforms.NewPlatformObj(*d.providerInfo.authenticationObj, zapLogger)
+
+	// get platforms list
+	items, err := platformObj.GetPlatformsListFlow()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting platforms list", err.Error())
+		return
+	}
+
+	item, diags := findSingle("platform", items,
+		int32Filter("platform_id", data.PlatformID, func(item entities.PlatformResponse) int { return item.PlatformID }),
+		stringFilter("name", data.Name, func(item entities.PlatformResponse) string { return item.Name }),
+		stringFilter("short_name", data.ShortName, func(item entities.PlatformResponse) string { return item.ShortName }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newPlatformModel(item))...)
+
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/platforms"
 )
 
@@ -59,88 +60,93 @@ func (d *PlatformDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"platforms": schema.ListNestedBlock{
 				Description: "Platform Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"platform_id": schema.Int32Attribute{
-							MarkdownDescription: "Platform ID",
-							Required:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Name",
-							Required:            true,
-						},
-						"short_name": schema.StringAttribute{
-							MarkdownDescription: "Short Name",
-							Required:            true,
-						},
-						"port_flag": schema.BoolAttribute{
-							MarkdownDescription: "Port Flag",
-							Required:            true,
-						},
-						"default_port": schema.Int32Attribute{
-							MarkdownDescription: "Default Port (nullable)",
-							Optional:            true,
-							Computed:            true,
-						},
-						"supports_elevation_flag": schema.BoolAttribute{
-							MarkdownDescription: "Supports Elevation Flag",
-							Required:            true,
-						},
-						"domain_name_flag": schema.BoolAttribute{
-							MarkdownDescription: "Domain Name Flag",
-							Required:            true,
-						},
-						"auto_management_flag": schema.BoolAttribute{
-							MarkdownDescription: "Auto Management Flag",
-							Required:            true,
-						},
-						"dss_auto_management_flag": schema.BoolAttribute{
-							MarkdownDescription: "DSS Auto Management Flag",
-							Required:            true,
-						},
-						"manageable_flag": schema.BoolAttribute{
-							MarkdownDescription: "Manageable Flag",
-							Required:            true,
-						},
-						"dss_flag": schema.BoolAttribute{
-							MarkdownDescription: "DSS Flag",
-							Required:            true,
-						},
-						"login_account_flag": schema.BoolAttribute{
-							MarkdownDescription: "Login Account Flag",
-							Required:            true,
-						},
-						"default_session_type": schema.StringAttribute{
-							MarkdownDescription: "Default Session Type (nullable)",
-							Optional:            true,
-							Computed:            true,
-						},
-						"application_host_flag": schema.BoolAttribute{
-							MarkdownDescription: "Application Host Flag",
-							Required:            true,
-						},
-						"requires_application_host": schema.BoolAttribute{
-							MarkdownDescription: "Requires Application Host",
-							Required:            true,
-						},
-						"requires_tenant_id": schema.BoolAttribute{
-							MarkdownDescription: "Requires Tenant ID",
-							Required:            true,
-						},
-						"requires_object_id": schema.BoolAttribute{
-							MarkdownDescription: "Requires Object ID",
-							Required:            true,
-						},
-						"requires_secret": schema.BoolAttribute{
-							MarkdownDescription: "Requires Secret",
-							Required:            true,
-						},
-					},
+					Attributes: getPlatformDataSourceSchemaAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// getPlatformDataSourceSchemaAttributes get schema attributes.
+func getPlatformDataSourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"platform_id": schema.Int32Attribute{
+			MarkdownDescription: "Platform ID",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name",
+			Required:            true,
+		},
+		"short_name": schema.StringAttribute{
+			MarkdownDescription: "Short Name",
+			Required:            true,
+		},
+		"port_flag": schema.BoolAttribute{
+			MarkdownDescription: "Port Flag",
+			Required:            true,
+		},
+		"default_port": schema.Int32Attribute{
+			MarkdownDescription: "Default Port (nullable)",
+			Optional:            true,
+			Computed:            true,
+		},
+		"supports_elevation_flag": schema.BoolAttribute{
+			MarkdownDescription: "Supports Elevation Flag",
+			Required:            true,
+		},
+		"domain_name_flag": schema.BoolAttribute{
+			MarkdownDescription: "Domain Name Flag",
+			Required:            true,
+		},
+		"auto_management_flag": schema.BoolAttribute{
+			MarkdownDescription: "Auto Management Flag",
+			Required:            true,
+		},
+		"dss_auto_management_flag": schema.BoolAttribute{
+			MarkdownDescription: "DSS Auto Management Flag",
+			Required:            true,
+		},
+		"manageable_flag": schema.BoolAttribute{
+			MarkdownDescription: "Manageable Flag",
+			Required:            true,
+		},
+		"dss_flag": schema.BoolAttribute{
+			MarkdownDescription: "DSS Flag",
+			Required:            true,
+		},
+		"login_account_flag": schema.BoolAttribute{
+			MarkdownDescription: "Login Account Flag",
+			Required:            true,
+		},
+		"default_session_type": schema.StringAttribute{
+			MarkdownDescription: "Default Session Type (nullable)",
+			Optional:            true,
+			Computed:            true,
+		},
+		"application_host_flag": schema.BoolAttribute{
+			MarkdownDescription: "Application Host Flag",
+			Required:            true,
+		},
+		"requires_application_host": schema.BoolAttribute{
+			MarkdownDescription: "Requires Application Host",
+			Required:            true,
+		},
+		"requires_tenant_id": schema.BoolAttribute{
+			MarkdownDescription: "Requires Tenant ID",
+			Required:            true,
+		},
+		"requires_object_id": schema.BoolAttribute{
+			MarkdownDescription: "Requires Object ID",
+			Required:            true,
+		},
+		"requires_secret": schema.BoolAttribute{
+			MarkdownDescription: "Requires Secret",
+			Required:            true,
+		},
+	}
+}
+
 func (d *PlatformDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 
 	// getting data from Provider
@@ -177,26 +183,7 @@ func (d *PlatformDataSource) Read(ctx context.Context, req datasource.ReadReques
 	var platformList []PlatformModel
 
 	for _, item := range items {
-		platformList = append(platformList, PlatformModel{
-			PlatformID:              types.Int32Value(int32(item.PlatformID)),
-			Name:                    types.StringValue(item.Name),
-			ShortName:               types.StringValue(item.ShortName),
-			PortFlag:                types.BoolValue(item.PortFlag),
-			DefaultPort:             types.Int32Value(int32(item.DefaultPort)),
-			SupportsElevationFlag:   types.BoolValue(item.SupportsElevationFlag),
-			DomainNameFlag:          types.BoolValue(item.DomainNameFlag),
-			AutoManagementFlag:      types.BoolValue(item.AutoManagementFlag),
-			DSSAutoManagementFlag:   types.BoolValue(item.DSSAutoManagementFlag),
-			ManageableFlag:          types.BoolValue(item.ManageableFlag),
-			DSSFlag:                 types.BoolValue(item.DSSFlag),
-			LoginAccountFlag:        types.BoolValue(item.LoginAccountFlag),
-			DefaultSessionType:      types.StringValue(item.DefaultSessionType),
-			ApplicationHostFlag:     types.BoolValue(item.ApplicationHostFlag),
-			RequiresApplicationHost: types.BoolValue(item.RequiresApplicationHost),
-			RequiresTenantID:        types.BoolValue(item.RequiresTenantID),
-			RequiresObjectID:        types.BoolValue(item.RequiresObjectID),
-			RequiresSecret:          types.BoolValue(item.RequiresSecret),
-		})
+		platformList = append(platformList, newPlatformModel(item))
 	}
 
 	responseData := PlatformDataSourceModel{}
@@ -205,3 +192,27 @@ func (d *PlatformDataSource) Read(ctx context.Context, req datasource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newPlatformModel returns the datasource attributes of a platform.
+func newPlatformModel(item entities.PlatformResponse) PlatformModel {
+	return PlatformModel{
+		PlatformID:              types.Int32Value(int32(item.PlatformID)),
+		Name:                    types.StringValue(item.Name),
+		ShortName:               types.StringValue(item.ShortName),
+		PortFlag:                types.BoolValue(item.PortFlag),
+		DefaultPort:             types.Int32Value(int32(item.DefaultPort)),
+		SupportsElevationFlag:   types.BoolValue(item.SupportsElevationFlag),
+		DomainNameFlag:          types.BoolValue(item.DomainNameFlag),
+		AutoManagementFlag:      types.BoolValue(item.AutoManagementFlag),
+		DSSAutoManagementFlag:   types.BoolValue(item.DSSAutoManagementFlag),
+		ManageableFlag:          types.BoolValue(item.ManageableFlag),
+		DSSFlag:                 types.BoolValue(item.DSSFlag),
+		LoginAccountFlag:        types.BoolValue(item.LoginAccountFlag),
+		DefaultSessionType:      types.StringValue(item.DefaultSessionType),
+		ApplicationHostFlag:     types.BoolValue(item.ApplicationHostFlag),
+		RequiresApplicationHost: types.BoolValue(item.RequiresApplicationHost),
+		RequiresTenantID:        types.BoolValue(item.RequiresTenantID),
+		RequiresObjectID:        types.BoolValue(item.RequiresObjectID),
+		RequiresSecret:          types.BoolValue(item.RequiresSecret),
+	}
+}
@@ -4,52 +4,44 @@ package provider_framework
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
-	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"go.uber.org/zap"
 	localutils "terraform-provider-passwordsafe/providers/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 var (
-	maxFileSecretSizeBytes     = 5000000
-	clientTimeOutInSeconds     = 45
-	retryMaxElapsedTimeMinutes = 15
+	maxFileSecretSizeBytes = 5000000
 )
 
 type PasswordSafeProvider struct {
+	sessionMu sync.Mutex
+	// sessionKey is the key of the shared session held since the last Configure.
+	sessionKey string
 }
 
-// Define the zap configuration
-var config = zap.Config{
-	Level:            zap.NewAtomicLevelAt(zap.DebugLevel),
-	Encoding:         "console", // You can use "json" for structured logging
-	EncoderConfig:    zap.NewDevelopmentEncoderConfig(),
-	OutputPaths:      []string{"stderr", "providerFramework.log"}, // Logs to both stderr and the file
-	ErrorOutputPaths: []string{"stderr"},
-}
-
-// Build the logger with the configuration
-var logger, _ = config.Build()
-
-// create a zap logger wrapper
-var zapLogger = logging.NewZapLogger(logger)
+// zapLogger sends the provider and client library logs to tflog, it is shared by both providers.
+var zapLogger = localutils.NewProviderLogger()
 
 func NewProvider() provider.Provider {
 	return &PasswordSafeProvider{}
@@ -70,22 +62,46 @@ type ProviderModel struct {
 	ClientCertificatesFolderPath types.String `tfsdk:"client_certificates_folder_path"`
 	ClientCertificateName        types.String `tfsdk:"client_certificate_name"`
 	ClientCertificatePassword    types.String `tfsdk:"client_certificate_password"`
+	ClientCertificatePEM         types.String `tfsdk:"client_certificate_pem"`
+	ClientKeyPEM                 types.String `tfsdk:"client_key_pem"`
+	ClientCertificateFile        types.String `tfsdk:"client_certificate_file"`
+	ClientKeyFile                types.String `tfsdk:"client_key_file"`
+	CACertFile                   types.String `tfsdk:"ca_cert_file"`
+	CACertPEM                    types.String `tfsdk:"ca_cert_pem"`
+	TLSServerName                types.String `tfsdk:"tls_server_name"`
+	ProxyURL                     types.String `tfsdk:"proxy_url"`
+	ProxyUsername                types.String `tfsdk:"proxy_username"`
+	ProxyPassword                types.String `tfsdk:"proxy_password"`
+	NoProxy                      types.String `tfsdk:"no_proxy"`
+	CredentialCache              types.Bool   `tfsdk:"credential_cache"`
+	CredentialCacheTTL           types.Int64  `tfsdk:"credential_cache_ttl"`
+	RequestTimeout               types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries                   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxElapsed              types.Int64  `tfsdk:"retry_max_elapsed"`
+	RetryInitialInterval         types.Int64  `tfsdk:"retry_initial_interval"`
+	CredentialsCommand           types.String `tfsdk:"credentials_command"`
+	CredentialsCommandArgs       types.List   `tfsdk:"credentials_command_args"`
+	CredentialsCommandTimeout    types.Int64  `tfsdk:"credentials_command_timeout"`
+	LogLevel                     types.String `tfsdk:"log_level"`
+	LogFile                      types.String `tfsdk:"log_file"`
 }
 
 type ProviderData struct {
 	//authenticate authentication.AuthenticationObj
-	apiKey                    string
-	clientId                  string
-	clientSecret              string
-	apiVersion                string
-	url                       string
-	accountname               string
-	clientCertificatePath     string
-	clientCertificateName     string
-	clientCertificatePassword string
-	verifyca                  bool
-	userName                  string
-	authenticationObj         *auth.AuthenticationObj
+	apiKey             string
+	clientId           string
+	clientSecret       string
+	apiVersion         string
+	url                string
+	accountname        string
+	clientCertificate  localutils.ClientCertificateSettings
+	verifyca           bool
+	credentialCache    bool
+	credentialCacheTTL int64
+	httpClientSettings localutils.HttpClientSettings
+	credentialsCommand localutils.CredentialsCommand
+	userName           string
+	authenticationObj  *auth.AuthenticationObj
 }
 
 func (p *PasswordSafeProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -93,99 +109,190 @@ func (p *PasswordSafeProvider) Schema(ctx context.Context, req provider.SchemaRe
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
 				Optional:    true,
-				Description: "The API key for making requests to the Password Safe instance. For use when authenticating to Password Safe.",
+				Description: "The API key for making requests to the Password Safe instance. For use when authenticating to Password Safe. Can also be set with the PS_API_KEY environment variable.",
 			},
 			"client_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "API OAuth Client ID.",
+				Description: "API OAuth Client ID. Can also be set with the PS_CLIENT_ID environment variable.",
 			},
 			"client_secret": schema.StringAttribute{
 				Optional:    true,
-				Description: "API OAuth Client Secret.",
+				Description: "API OAuth Client Secret. Can also be set with the PS_CLIENT_SECRET environment variable.",
 			},
 			"url": schema.StringAttribute{
-				Required:    true,
-				Description: "The URL for the Password Safe instance from which to request a secret.",
+				Optional:    true,
+				Description: "The URL for the Password Safe instance from which to request a secret. Can also be set with the PS_URL environment variable.",
 			},
 			"api_version": schema.StringAttribute{
 				Optional:    true,
-				Description: "The recommended version is 3.1. If no version is specified, the default API version 3.0 will be used",
+				Description: "The recommended version is 3.1. If no version is specified, the default API version 3.0 will be used. Can also be set with the PS_API_VERSION environment variable.",
 			},
 			"api_account_name": schema.StringAttribute{
-				Required:    true,
-				Description: "The user name for the API request to the Password Safe instance. For use when authenticating with an API key, it is used as the “runas user” in the authorization header of the request.",
+				Optional:    true,
+				Description: "The user name for the API request to the Password Safe instance. For use when authenticating with an API key, it is used as the “runas user” in the authorization header of the request. Can also be set with the PS_ACCOUNT_NAME environment variable.",
 			},
 			"verify_ca": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Indicates whether to verify the certificate authority on the Password Safe instance. For use when authenticating to Password Safe.",
+				Description: "Indicates whether to verify the certificate authority on the Password Safe instance. For use when authenticating to Password Safe. Can also be set with the PS_VERIFY_CA environment variable.",
 			},
 			"client_certificates_folder_path": schema.StringAttribute{
 				Optional:    true,
-				Description: "The path to the Client Certificate associated with the Password Safe instance for use when authenticating with an API key using a Client Certificate.",
+				Description: "The path to the Client Certificate associated with the Password Safe instance for use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_PATH environment variable.",
 			},
 			"client_certificate_name": schema.StringAttribute{
 				Optional:    true,
-				Description: "The name of the Client Certificate for use when authenticating with an API key using a Client Certificate.",
+				Description: "The name of the Client Certificate for use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_NAME environment variable.",
 			},
 			"client_certificate_password": schema.StringAttribute{
 				Optional:    true,
-				Description: "The password associated with the Client Certificate. For use when authenticating with an API key using a Client Certificate",
+				Description: "The password associated with the Client Certificate. For use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_PASSWORD environment variable.",
+			},
+			"client_certificate_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate for mutual TLS, as an alternative to a PFX file. Requires client_key_pem or client_key_file. Can also be set with the PS_CLIENT_CERTIFICATE_PEM environment variable.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the client certificate (PKCS#8, PKCS#1 or SEC 1). An encrypted PKCS#8 key is decrypted with client_certificate_password. Can also be set with the PS_CLIENT_KEY_PEM environment variable.",
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM file with the client certificate for mutual TLS, as an alternative to client_certificate_pem. Can also be set with the PS_CLIENT_CERTIFICATE_FILE environment variable.",
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM file with the private key of the client certificate, as an alternative to client_key_pem. Can also be set with the PS_CLIENT_KEY_FILE environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM file with CA certificates trusted in addition to the system roots to verify the Password Safe instance. Can also be set with the PS_CA_CERT_FILE environment variable.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates trusted in addition to the system roots to verify the Password Safe instance. Can also be set with the PS_CA_CERT_PEM environment variable.",
+			},
+			"tls_server_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Server name used to verify the certificate of the Password Safe instance when it differs from the host in url, for example behind a load balancer. Can also be set with the PS_TLS_SERVER_NAME environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy used to reach the Password Safe instance (http, https or socks5). When not set, the HTTPS_PROXY and HTTP_PROXY environment variables are used. Can also be set with the PS_PROXY_URL environment variable.",
+			},
+			"proxy_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "User name to authenticate to the proxy. Can also be set with the PS_PROXY_USERNAME environment variable.",
+			},
+			"proxy_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to authenticate to the proxy. Can also be set with the PS_PROXY_PASSWORD environment variable.",
+			},
+			"no_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comma-separated list of hosts, domains or CIDR ranges reached without the proxy. When not set, the NO_PROXY environment variable is used. Can also be set with the PS_NO_PROXY environment variable.",
+			},
+			"credential_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to cache retrieved credentials in memory for the rest of the run, so several blocks referencing the same account or secret raise a single request. Credentials are never written to disk. Defaults to false. Can also be set with the PS_CREDENTIAL_CACHE environment variable.",
+			},
+			"credential_cache_ttl": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Time in seconds a cached credential stays valid when credential_cache is enabled. When not set or 0, credentials are cached until the provider exits. Can also be set with the PS_CREDENTIAL_CACHE_TTL environment variable.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout in seconds of each HTTP request to the Password Safe API (default: 45). Can also be set with the PS_REQUEST_TIMEOUT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries of idempotent requests (GET, HEAD, OPTIONS, PUT, DELETE) that fail with a network error, a 429 or a 5xx response (default: 3). Can also be set with the PS_MAX_RETRIES environment variable.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_max_elapsed": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds spent retrying a request, 0 means no limit (default: 30). Can also be set with the PS_RETRY_MAX_ELAPSED environment variable.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			"retry_initial_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Time in seconds to wait before the first retry, the wait time grows exponentially between retries (default: 1). Can also be set with the PS_RETRY_INITIAL_INTERVAL environment variable.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"credentials_command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command run to get the API credentials instead of setting them in the configuration. It must print a JSON object with api_key (and optionally api_account_name) or client_id and client_secret, and optionally expires_at in RFC 3339 format. The command runs again once the credentials expired. Can also be set with the PS_CREDENTIALS_COMMAND environment variable.",
+			},
+			"credentials_command_args": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arguments passed to credentials_command, the command is not run through a shell.",
+			},
+			"credentials_command_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout in seconds of credentials_command (default: 30). Can also be set with the PS_CREDENTIALS_COMMAND_TIMEOUT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+				},
+			},
+			"log_level": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum level of the provider logs: debug, info, warn or error (default: debug). Logs are sent to Terraform, which shows them according to TF_LOG_PROVIDER. Can also be set with the PS_LOG_LEVEL environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(localutils.LogLevels...),
+				},
+			},
+			"log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File the provider logs are appended to, in addition to the Terraform logs. Secrets are redacted. Can also be set with the PS_LOG_FILE environment variable.",
 			},
 		},
 	}
 }
 
-// ValidateCredentialsAndConfig make basic validations in credential and config data.
-func (p *PasswordSafeProvider) ValidateCredentialsAndConfig(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse, data ProviderData) error {
-	var errorSummary string
-
-	if data.apiKey == "" && data.clientId == "" && data.clientSecret == "" {
-		errorSummary = "invalid authentication method"
-		resp.Diagnostics.AddError(fmt.Sprintf("Error: %s", errorSummary), "Please add a valid credential (API Key / Client Credentials)")
-		return errors.New(errorSummary)
-	}
-
-	if data.url == "" {
-		errorSummary = "invalid URL"
-		resp.Diagnostics.AddError(fmt.Sprintf("Error: %s", errorSummary), "Please add a proper URL")
-		return errors.New(errorSummary)
+func (p *PasswordSafeProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providerConfigValidator{},
 	}
-
-	if data.apiKey != "" && data.accountname == "" {
-		errorSummary = "invalid account Name"
-		resp.Diagnostics.AddError(fmt.Sprintf("Error: %s", errorSummary), "Please add a proper Account Name")
-		return errors.New(errorSummary)
-	}
-	return nil
 }
 
-// GetCertificateData decrypt pfx file to get certificate and certificate key data.
+// GetCertificateData gets the client certificate and certificate key data from the PFX file or the PEM options.
 func (p *PasswordSafeProvider) GetCertificateData(resp *provider.ConfigureResponse, data ProviderData) (string, string) {
-	if data.clientCertificateName != "" {
-		certificate, certificateKey, err := utils.GetPFXContent(data.clientCertificatePath, data.clientCertificateName, data.clientCertificatePassword, zapLogger)
-		if err != nil {
-			resp.Diagnostics.AddError("Error in certificate", err.Error())
-		}
-		return certificate, certificateKey
+	certificate, certificateKey, err := localutils.GetClientCertificate(data.clientCertificate, zapLogger)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in certificate", err.Error())
 	}
-	return "", ""
+	return certificate, certificateKey
 }
 
-func (p *PasswordSafeProvider) buildAuthenticationObj(httpClient utils.HttpClientObj, backoffDefinition *backoff.ExponentialBackOff, data ProviderModel) (*auth.AuthenticationObj, error) {
+func (p *PasswordSafeProvider) buildAuthenticationObj(httpClient utils.HttpClientObj, backoffDefinition *backoff.ExponentialBackOff, data ProviderData) (*auth.AuthenticationObj, error) {
 	base := auth.AuthenticationParametersObj{
 		HTTPClient:                 httpClient,
 		BackoffDefinition:          backoffDefinition,
-		EndpointURL:                strings.TrimSpace(data.Url.ValueString()),
-		APIVersion:                 data.APIVersion.ValueString(),
+		EndpointURL:                data.url,
+		APIVersion:                 data.apiVersion,
 		Logger:                     zapLogger,
-		RetryMaxElapsedTimeSeconds: 30,
+		RetryMaxElapsedTimeSeconds: int(data.httpClientSettings.RetryMaxElapsed.Seconds()),
 	}
-	if strings.TrimSpace(data.APIKey.ValueString()) != "" {
-		base.ApiKey = fmt.Sprintf("%v;runas=%v;", strings.TrimSpace(data.APIKey.ValueString()), strings.TrimSpace(data.APIAccountName.ValueString()))
+	if data.apiKey != "" {
+		base.ApiKey = fmt.Sprintf("%v;runas=%v;", data.apiKey, data.accountname)
 		return auth.AuthenticateUsingApiKey(base)
 	}
-	base.ClientID = data.ClientId.ValueString()
-	base.ClientSecret = data.ClientSecret.ValueString()
+	base.ClientID = data.clientId
+	base.ClientSecret = data.clientSecret
 	return auth.Authenticate(base)
 }
 
@@ -193,35 +300,42 @@ func (p *PasswordSafeProvider) Configure(ctx context.Context, req provider.Confi
 
 	var data ProviderModel
 
-	var err error
-
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	providerData := ProviderData{
-		apiKey:                    strings.TrimSpace(data.APIKey.ValueString()),
-		clientId:                  data.ClientId.ValueString(),
-		clientSecret:              data.ClientSecret.ValueString(),
-		url:                       strings.TrimSpace(data.Url.ValueString()),
-		apiVersion:                data.APIVersion.ValueString(),
-		accountname:               strings.TrimSpace(data.APIAccountName.ValueString()),
-		verifyca:                  data.VerifyCA.IsNull() || data.VerifyCA.ValueBool(),
-		clientCertificatePath:     data.ClientCertificatesFolderPath.ValueString(),
-		clientCertificateName:     data.ClientCertificateName.ValueString(),
-		clientCertificatePassword: data.ClientCertificatePassword.ValueString(),
+	if err := configureLogging(data); err != nil {
+		resp.Diagnostics.AddError("Error in logging configuration", err.Error())
+		return
 	}
 
-	// Make basic validations.
-	err = p.ValidateCredentialsAndConfig(ctx, req, resp, providerData)
+	// provider block values take precedence over PS_* environment variables.
+	providerData, err := newProviderData(data)
 	if err != nil {
+		resp.Diagnostics.AddError("Error in provider configuration", err.Error())
+		return
+	}
+
+	// Make basic validations.
+	config := newProviderConfig(data)
+	config.RequireCredentials = true
+	resp.Diagnostics.Append(validateProviderConfig(config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err = applyCommandCredentials(&providerData); err != nil {
+		resp.Diagnostics.AddError("Error getting credentials from credentials_command", err.Error())
 		return
 	}
 
 	// Get Cerificate and certificate key.
 	certificate, certificateKey := p.GetCertificateData(resp, providerData)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create an instance of ValidationParams
 	params := utils.ValidationParams{
@@ -229,12 +343,12 @@ func (p *PasswordSafeProvider) Configure(ctx context.Context, req provider.Confi
 		ClientSecret:               providerData.clientSecret,
 		ApiUrl:                     &providerData.url,
 		ApiVersion:                 providerData.apiVersion,
-		ClientTimeOutInSeconds:     clientTimeOutInSeconds,
+		ClientTimeOutInSeconds:     int(providerData.httpClientSettings.RequestTimeout.Seconds()),
 		VerifyCa:                   providerData.verifyca,
 		Logger:                     zapLogger,
 		Certificate:                certificate,
 		CertificateKey:             certificateKey,
-		RetryMaxElapsedTimeMinutes: &retryMaxElapsedTimeMinutes,
+		RetryMaxElapsedTimeMinutes: localutils.LibraryRetryMaxElapsedMinutes(providerData.httpClientSettings.RetryMaxElapsed),
 	}
 
 	// validate inputs
@@ -245,11 +359,6 @@ func (p *PasswordSafeProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	backoffDefinition := backoff.NewExponentialBackOff()
-	backoffDefinition.InitialInterval = 1 * time.Second
-	backoffDefinition.MaxElapsedTime = time.Duration(retryMaxElapsedTimeMinutes) * time.Second
-	backoffDefinition.RandomizationFactor = 0.5
-
 	cacheKey := strings.Join([]string{
 		providerData.url,
 		providerData.apiVersion,
@@ -258,21 +367,28 @@ func (p *PasswordSafeProvider) Configure(ctx context.Context, req provider.Confi
 		providerData.clientSecret,
 		providerData.accountname,
 		fmt.Sprintf("%t", providerData.verifyca),
-		providerData.clientCertificateName,
+		localutils.CertificateFingerprint(certificate),
+		providerData.httpClientSettings.String(),
+		fmt.Sprintf("%t", providerData.credentialCache),
+		fmt.Sprintf("%d", providerData.credentialCacheTTL),
 	}, "|")
 
 	authenticate, signAppin, err := localutils.InitSharedAuth(cacheKey, func() (*auth.AuthenticationObj, error) {
-		httpClientObj, err := utils.GetHttpClient(clientTimeOutInSeconds, providerData.verifyca, certificate, certificateKey, zapLogger)
+		httpClientObj, err := localutils.NewHttpClient(providerData.verifyca, certificate, certificateKey, providerData.httpClientSettings, zapLogger)
 		if err != nil {
 			return nil, err
 		}
-		return p.buildAuthenticationObj(*httpClientObj, backoffDefinition, data)
+		return p.buildAuthenticationObj(*httpClientObj, localutils.NewLibraryBackOff(), providerData)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error in Provider", err.Error())
 		return
 	}
 
+	p.holdSession(cacheKey)
+	p.enableCommandCredentialsRenewal(authenticate, providerData)
+	localutils.ConfigureCredentialCache(authenticate, providerData.credentialCache, time.Duration(providerData.credentialCacheTTL)*time.Second)
+
 	providerData.userName = signAppin.UserName
 	providerData.authenticationObj = authenticate
 
@@ -282,11 +398,49 @@ func (p *PasswordSafeProvider) Configure(ctx context.Context, req provider.Confi
 	resp.ResourceData = providerData
 	// pass data to ephemeral resources
 	resp.DataSourceData = providerData
+	// pass data to actions
+	resp.ActionData = providerData
+	// pass data to list resources
+	resp.ListResourceData = providerData
+
+}
+
+// enableCommandCredentialsRenewal makes the session sign in again with the credentials of credentials_command
+// when it expired, so expired command credentials are replaced.
+func (p *PasswordSafeProvider) enableCommandCredentialsRenewal(authObj *auth.AuthenticationObj, data ProviderData) {
+	if data.credentialsCommand.Command == "" {
+		return
+	}
+
+	localutils.EnableCommandCredentialsRenewal(authObj, data.credentialsCommand, func(httpClient utils.HttpClientObj, credentials localutils.APICredentials) (*auth.AuthenticationObj, error) {
+		data.setCommandCredentials(credentials)
+		return p.buildAuthenticationObj(httpClient, localutils.NewLibraryBackOff(), data)
+	})
+}
+
+// holdSession records the shared session taken by Configure and releases the one
+// taken by the previous Configure, so a session is signed out once no provider uses it.
+func (p *PasswordSafeProvider) holdSession(cacheKey string) {
+	p.sessionMu.Lock()
+	defer p.sessionMu.Unlock()
 
+	if p.sessionKey != "" {
+		_ = localutils.ReleaseSharedAuth(p.sessionKey)
+	}
+	p.sessionKey = cacheKey
 }
 
 func (p *PasswordSafeProvider) Functions(_ context.Context) []func() function.Function {
-	return nil
+	return []func() function.Function{
+		NewSecretPathFunction,
+		NewParseSecretPathFunction,
+		NewManagedAccountPathFunction,
+		NewParseManagedAccountPathFunction,
+		NewNormalizeFolderPathFunction,
+		NewGeneratePasswordFunction,
+		NewNextChangeDateFunction,
+		NewValidateChangeScheduleFunction,
+	}
 }
 
 func (p *PasswordSafeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -300,6 +454,15 @@ func (p *PasswordSafeProvider) DataSources(ctx context.Context) []func() datasou
 		NewManagedAccountDataSource,
 		NewManagedSystemDataSource,
 		NewAssetDataSource,
+		NewAliasDataSource,
+		NewSecretVersionsDataSource,
+		NewManagedSystemSingleDataSource,
+		NewPlatformSingleDataSource,
+		NewWorkgroupSingleDataSource,
+		NewDatabaseSingleDataSource,
+		NewFunctionalAccountSingleDataSource,
+		NewAssetSingleDataSource,
+		NewSecretMetadataDataSource,
 	}
 }
 
@@ -319,10 +482,40 @@ func (p *PasswordSafeProvider) Resources(_ context.Context) []func() resource.Re
 func (p *PasswordSafeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		NewEphemeralSecret,
+		NewEphemeralSecretVersions,
 		NewEphemeralManagedAccount,
+		NewEphemeralPassword,
+	}
+}
+
+func (p *PasswordSafeProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRotateManagedAccountAction,
+		NewTestCredentialsAction,
+		NewTestFunctionalAccountCredentialsAction,
+		NewTerminateRequestsAction,
+	}
+}
+
+func (p *PasswordSafeProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAssetByWorkGroupIdListResource,
+		NewAssetByWorkGroupNameListResource,
+		NewDatabaseListResource,
+		NewManagedSystemByAssetListResource,
+		NewManagedSystemByWorkGroupListResource,
+		NewManagedSystemByDatabaseListResource,
+		NewFunctionalAccountListResource,
+		NewManagedAccountListResource,
+		NewCredentialSecretListResource,
+		NewTextSecretListResource,
+		NewFileSecretListResource,
 	}
 }
 
 var _ provider.Provider = &PasswordSafeProvider{}
 var _ provider.ProviderWithFunctions = &PasswordSafeProvider{}
 var _ provider.ProviderWithEphemeralResources = &PasswordSafeProvider{}
+var _ provider.ProviderWithConfigValidators = &PasswordSafeProvider{}
+var _ provider.ProviderWithActions = &PasswordSafeProvider{}
+var _ provider.ProviderWithListResources = &PasswordSafeProvider{}
@@ -0,0 +1,216 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-passwordsafe/providers/constants"
+	localutils "terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newProviderData builds provider data from the provider block, falling back to the
+// PS_* environment variables for every attribute that is not set.
+func newProviderData(data ProviderModel) (ProviderData, error) {
+	verifyCA, err := boolOrEnv(data.VerifyCA, constants.EnvVerifyCA, true)
+	if err != nil {
+		return ProviderData{}, err
+	}
+
+	credentialCache, err := boolOrEnv(data.CredentialCache, constants.EnvCredentialCache, false)
+	if err != nil {
+		return ProviderData{}, err
+	}
+
+	credentialCacheTTL, err := int64OrEnv(data.CredentialCacheTTL, constants.EnvCredentialCacheTTL, 0)
+	if err != nil {
+		return ProviderData{}, err
+	}
+
+	httpClientSettings, err := newHttpClientSettings(data)
+	if err != nil {
+		return ProviderData{}, err
+	}
+
+	credentialsCommand, err := newCredentialsCommand(data)
+	if err != nil {
+		return ProviderData{}, err
+	}
+
+	return ProviderData{
+		apiKey:       strings.TrimSpace(stringOrEnv(data.APIKey, constants.EnvAPIKey)),
+		clientId:     stringOrEnv(data.ClientId, constants.EnvClientID),
+		clientSecret: stringOrEnv(data.ClientSecret, constants.EnvClientSecret),
+		url:          strings.TrimSpace(stringOrEnv(data.Url, constants.EnvURL)),
+		apiVersion:   stringOrEnv(data.APIVersion, constants.EnvAPIVersion),
+		accountname:  strings.TrimSpace(stringOrEnv(data.APIAccountName, constants.EnvAPIAccountName)),
+		verifyca:     verifyCA,
+		clientCertificate: localutils.ClientCertificateSettings{
+			FolderPath:      stringOrEnv(data.ClientCertificatesFolderPath, constants.EnvClientCertificatesPath),
+			Name:            stringOrEnv(data.ClientCertificateName, constants.EnvClientCertificateName),
+			Password:        stringOrEnv(data.ClientCertificatePassword, constants.EnvClientCertificatePassword),
+			CertificatePEM:  stringOrEnv(data.ClientCertificatePEM, constants.EnvClientCertificatePEM),
+			KeyPEM:          stringOrEnv(data.ClientKeyPEM, constants.EnvClientKeyPEM),
+			CertificateFile: stringOrEnv(data.ClientCertificateFile, constants.EnvClientCertificateFile),
+			KeyFile:         stringOrEnv(data.ClientKeyFile, constants.EnvClientKeyFile),
+		},
+		credentialCache:    credentialCache,
+		credentialCacheTTL: credentialCacheTTL,
+		httpClientSettings: httpClientSettings,
+		credentialsCommand: credentialsCommand,
+	}, nil
+}
+
+// newCredentialsCommand builds the credentials command settings from the provider block and PS_* environment variables.
+func newCredentialsCommand(data ProviderModel) (localutils.CredentialsCommand, error) {
+	timeout, err := int64OrEnv(data.CredentialsCommandTimeout, constants.EnvCredentialsCommandTimeout, localutils.DefaultCredentialsCommandTimeoutSeconds)
+	if err != nil {
+		return localutils.CredentialsCommand{}, err
+	}
+
+	args := []string{}
+	if !data.CredentialsCommandArgs.IsNull() && !data.CredentialsCommandArgs.IsUnknown() {
+		if diags := data.CredentialsCommandArgs.ElementsAs(context.Background(), &args, false); diags.HasError() {
+			return localutils.CredentialsCommand{}, errors.New("invalid credentials_command_args, a list of strings is expected")
+		}
+	}
+
+	return localutils.CredentialsCommand{
+		Command: strings.TrimSpace(stringOrEnv(data.CredentialsCommand, constants.EnvCredentialsCommand)),
+		Args:    args,
+		Timeout: time.Duration(timeout) * time.Second,
+	}, nil
+}
+
+// applyCommandCredentials runs credentials_command when set and uses its output as the API credentials.
+func applyCommandCredentials(data *ProviderData) error {
+	credentials, err := localutils.ResolveCommandCredentials(data.credentialsCommand, localutils.APICredentials{
+		APIKey:         data.apiKey,
+		APIAccountName: data.accountname,
+		ClientID:       data.clientId,
+		ClientSecret:   data.clientSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	localutils.AddLogSecrets(credentials.APIKey, credentials.ClientSecret)
+	data.setCommandCredentials(credentials)
+	return nil
+}
+
+// setCommandCredentials replaces the API credentials with the output of credentials_command, the api_account_name
+// of the provider block is kept when the command does not return one.
+func (data *ProviderData) setCommandCredentials(credentials localutils.APICredentials) {
+	data.apiKey = strings.TrimSpace(credentials.APIKey)
+	data.clientId = credentials.ClientID
+	data.clientSecret = credentials.ClientSecret
+	if credentials.APIAccountName != "" {
+		data.accountname = strings.TrimSpace(credentials.APIAccountName)
+	}
+}
+
+// newHttpClientSettings builds the transport settings from the provider block and PS_* environment variables.
+func newHttpClientSettings(data ProviderModel) (localutils.HttpClientSettings, error) {
+	requestTimeout, err := int64OrEnv(data.RequestTimeout, constants.EnvRequestTimeout, localutils.DefaultRequestTimeoutSeconds)
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+
+	maxRetries, err := int64OrEnv(data.MaxRetries, constants.EnvMaxRetries, localutils.DefaultMaxRetries)
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+
+	retryMaxElapsed, err := int64OrEnv(data.RetryMaxElapsed, constants.EnvRetryMaxElapsed, localutils.DefaultRetryMaxElapsedSeconds)
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+
+	retryInitialInterval, err := int64OrEnv(data.RetryInitialInterval, constants.EnvRetryInitialInterval, localutils.DefaultRetryInitialIntervalSeconds)
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+
+	settings := localutils.NewHttpClientSettings(int(requestTimeout), int(maxRetries), int(retryMaxElapsed), int(retryInitialInterval))
+	settings.TLSServerName = stringOrEnv(data.TLSServerName, constants.EnvTLSServerName)
+	settings.ProxyURL = stringOrEnv(data.ProxyURL, constants.EnvProxyURL)
+	settings.ProxyUsername = stringOrEnv(data.ProxyUsername, constants.EnvProxyUsername)
+	settings.ProxyPassword = stringOrEnv(data.ProxyPassword, constants.EnvProxyPassword)
+	settings.NoProxy = stringOrEnv(data.NoProxy, constants.EnvNoProxy)
+	settings.CACertificates, err = localutils.LoadCACertificates(stringOrEnv(data.CACertPEM, constants.EnvCACertPEM), stringOrEnv(data.CACertFile, constants.EnvCACertFile))
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+
+	return settings, settings.Validate()
+}
+
+// configureLogging applies log_level and log_file, the configured credentials are redacted from the logs.
+func configureLogging(data ProviderModel) error {
+	return localutils.ConfigureLogging(
+		stringOrEnv(data.LogLevel, constants.EnvLogLevel),
+		stringOrEnv(data.LogFile, constants.EnvLogFile),
+		stringOrEnv(data.APIKey, constants.EnvAPIKey),
+		stringOrEnv(data.ClientSecret, constants.EnvClientSecret),
+		stringOrEnv(data.ClientCertificatePassword, constants.EnvClientCertificatePassword),
+		stringOrEnv(data.ClientKeyPEM, constants.EnvClientKeyPEM),
+		stringOrEnv(data.ProxyPassword, constants.EnvProxyPassword),
+	)
+}
+
+// stringOrEnv returns the attribute value when set in the provider block, otherwise the environment variable value.
+// Like the EnvDefaultFunc of the SDKv2 provider, only a null attribute falls back to the environment variable, an
+// empty string set in the provider block is kept.
+func stringOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// boolOrEnv returns the attribute value when set in the provider block, otherwise the environment variable value
+// or defaultValue when the environment variable is not set either.
+func boolOrEnv(value types.Bool, envVar string, defaultValue bool) (bool, error) {
+	if !value.IsNull() {
+		return value.ValueBool(), nil
+	}
+
+	envValue, ok := os.LookupEnv(envVar)
+	if !ok || envValue == "" {
+		return defaultValue, nil
+	}
+
+	parsedValue, err := strconv.ParseBool(envValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q in %v environment variable, a boolean is expected", envValue, envVar)
+	}
+	return parsedValue, nil
+}
+
+// int64OrEnv returns the attribute value when set in the provider block, otherwise the environment variable value
+// or defaultValue when the environment variable is not set either.
+func int64OrEnv(value types.Int64, envVar string, defaultValue int64) (int64, error) {
+	if !value.IsNull() {
+		return value.ValueInt64(), nil
+	}
+
+	envValue, ok := os.LookupEnv(envVar)
+	if !ok || envValue == "" {
+		return defaultValue, nil
+	}
+
+	parsedValue, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || parsedValue < 0 {
+		return 0, fmt.Errorf("invalid value %q in %v environment variable, a non-negative number is expected", envValue, envVar)
+	}
+	return parsedValue, nil
+}
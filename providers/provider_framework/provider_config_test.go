@@ -0,0 +1,246 @@
+package provider_framework
+
+import (
+	"terraform-provider-passwordsafe/providers/constants"
+	localutils "terraform-provider-passwordsafe/providers/utils"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewProviderDataFromEnvironment(t *testing.T) {
+	t.Setenv(constants.EnvURL, " https://example.com/BeyondTrust/api/public/v3 ")
+	t.Setenv(constants.EnvAPIAccountName, "test-account")
+	t.Setenv(constants.EnvClientID, constants.FakeClientId)
+	t.Setenv(constants.EnvClientSecret, constants.FakeClientSecret)
+	t.Setenv(constants.EnvVerifyCA, "false")
+	t.Setenv(constants.EnvCredentialCache, "true")
+	t.Setenv(constants.EnvCredentialCacheTTL, "60")
+
+	providerData, err := newProviderData(ProviderModel{
+		ClientId: types.StringValue("from-config"),
+	})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+
+	if providerData.url != "https://example.com/BeyondTrust/api/public/v3" {
+		t.Errorf("Expected url from environment, got %q", providerData.url)
+	}
+	if providerData.clientId != "from-config" {
+		t.Errorf("Expected client_id from config to take precedence, got %q", providerData.clientId)
+	}
+	if providerData.clientSecret != constants.FakeClientSecret || providerData.accountname != "test-account" {
+		t.Errorf("Unexpected provider data %+v", providerData)
+	}
+	if providerData.verifyca || !providerData.credentialCache || providerData.credentialCacheTTL != 60 {
+		t.Errorf("Unexpected provider data %+v", providerData)
+	}
+}
+
+func TestNewProviderDataEmptyValues(t *testing.T) {
+	t.Setenv(constants.EnvURL, "https://example.com/BeyondTrust/api/public/v3")
+	t.Setenv(constants.EnvAPIAccountName, "test-account")
+	t.Setenv(constants.EnvMaxRetries, "5")
+
+	// as in the SDKv2 provider, only null attributes fall back to the environment variables.
+	providerData, err := newProviderData(ProviderModel{
+		Url:            types.StringUnknown(),
+		APIAccountName: types.StringValue(""),
+		MaxRetries:     types.Int64Value(0),
+	})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+
+	if providerData.url != "" || providerData.accountname != "" || providerData.httpClientSettings.MaxRetries != 0 {
+		t.Errorf("Expected the values of the provider block, got %+v", providerData)
+	}
+}
+
+func TestNewProviderDataDefaults(t *testing.T) {
+	t.Setenv(constants.EnvVerifyCA, "")
+	t.Setenv(constants.EnvCredentialCache, "")
+	t.Setenv(constants.EnvCredentialCacheTTL, "")
+	t.Setenv(constants.EnvRequestTimeout, "")
+	t.Setenv(constants.EnvMaxRetries, "")
+	t.Setenv(constants.EnvRetryMaxElapsed, "")
+	t.Setenv(constants.EnvRetryInitialInterval, "")
+	t.Setenv(constants.EnvCACertPEM, "")
+	t.Setenv(constants.EnvCACertFile, "")
+	t.Setenv(constants.EnvTLSServerName, "")
+	t.Setenv(constants.EnvProxyURL, "")
+	t.Setenv(constants.EnvProxyUsername, "")
+	t.Setenv(constants.EnvProxyPassword, "")
+	t.Setenv(constants.EnvNoProxy, "")
+
+	providerData, err := newProviderData(ProviderModel{})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+
+	if !providerData.verifyca || providerData.credentialCache || providerData.credentialCacheTTL != 0 {
+		t.Errorf("Unexpected defaults %+v", providerData)
+	}
+
+	expectedSettings := localutils.NewHttpClientSettings(localutils.DefaultRequestTimeoutSeconds, localutils.DefaultMaxRetries, localutils.DefaultRetryMaxElapsedSeconds, localutils.DefaultRetryInitialIntervalSeconds)
+	if providerData.httpClientSettings != expectedSettings {
+		t.Errorf("Unexpected default http client settings %+v", providerData.httpClientSettings)
+	}
+}
+
+func TestNewProviderDataHttpClientSettings(t *testing.T) {
+	t.Setenv(constants.EnvRequestTimeout, "10")
+	t.Setenv(constants.EnvMaxRetries, "5")
+	t.Setenv(constants.EnvTLSServerName, "passwordsafe.example.com")
+	t.Setenv(constants.EnvProxyURL, "http://proxy.example.com:3128")
+	t.Setenv(constants.EnvProxyUsername, "proxy-user")
+
+	providerData, err := newProviderData(ProviderModel{
+		MaxRetries:      types.Int64Value(0),
+		RetryMaxElapsed: types.Int64Value(120),
+	})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+
+	expectedSettings := localutils.NewHttpClientSettings(10, 0, 120, localutils.DefaultRetryInitialIntervalSeconds)
+	expectedSettings.TLSServerName = "passwordsafe.example.com"
+	expectedSettings.ProxyURL = "http://proxy.example.com:3128"
+	expectedSettings.ProxyUsername = "proxy-user"
+	if providerData.httpClientSettings != expectedSettings {
+		t.Errorf("Unexpected http client settings %+v", providerData.httpClientSettings)
+	}
+}
+
+func TestNewProviderDataInvalidEnvironment(t *testing.T) {
+	tests := []struct {
+		name   string
+		envVar string
+		value  string
+	}{
+		{name: "invalid verify_ca", envVar: constants.EnvVerifyCA, value: "maybe"},
+		{name: "invalid credential_cache", envVar: constants.EnvCredentialCache, value: "yes please"},
+		{name: "invalid credential_cache_ttl", envVar: constants.EnvCredentialCacheTTL, value: "-1"},
+		{name: "invalid request_timeout", envVar: constants.EnvRequestTimeout, value: "0"},
+		{name: "invalid max_retries", envVar: constants.EnvMaxRetries, value: "11"},
+		{name: "invalid retry_initial_interval", envVar: constants.EnvRetryInitialInterval, value: "abc"},
+		{name: "invalid ca_cert_pem", envVar: constants.EnvCACertPEM, value: "not a certificate"},
+		{name: "missing ca_cert_file", envVar: constants.EnvCACertFile, value: "/missing/ca.pem"},
+		{name: "invalid proxy_url", envVar: constants.EnvProxyURL, value: "ftp://proxy.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.envVar, tt.value)
+
+			if _, err := newProviderData(ProviderModel{}); err == nil {
+				t.Errorf("Expected error for %v=%v", tt.envVar, tt.value)
+			}
+		})
+	}
+}
+
+func TestNewProviderDataClientCertificate(t *testing.T) {
+	t.Setenv(constants.EnvClientCertificateFile, "/certs/client.crt")
+	t.Setenv(constants.EnvClientKeyPEM, "key-from-env")
+	t.Setenv(constants.EnvClientCertificatePassword, "key-password")
+
+	providerData, err := newProviderData(ProviderModel{
+		ClientKeyPEM: types.StringValue("key-from-config"),
+	})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+
+	expected := localutils.ClientCertificateSettings{
+		Password:        "key-password",
+		KeyPEM:          "key-from-config",
+		CertificateFile: "/certs/client.crt",
+	}
+	if providerData.clientCertificate != expected {
+		t.Errorf("Unexpected client certificate settings %+v", providerData.clientCertificate)
+	}
+}
+
+func TestApplyCommandCredentials(t *testing.T) {
+	localutils.ClearCommandCredentials()
+	t.Setenv(constants.EnvCredentialsCommandTimeout, "5")
+
+	providerData, err := newProviderData(ProviderModel{
+		APIAccountName:     types.StringValue("test-account"),
+		CredentialsCommand: types.StringValue("sh"),
+		CredentialsCommandArgs: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("-c"),
+			types.StringValue(`echo '{"client_id": "command-client-id", "client_secret": "command-client-secret"}'`),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("newProviderData: %v", err)
+	}
+	if providerData.credentialsCommand.Timeout != 5*time.Second || len(providerData.credentialsCommand.Args) != 2 {
+		t.Errorf("Unexpected credentials command %+v", providerData.credentialsCommand)
+	}
+
+	if err = applyCommandCredentials(&providerData); err != nil {
+		t.Fatalf("applyCommandCredentials: %v", err)
+	}
+	if providerData.clientId != "command-client-id" || providerData.clientSecret != "command-client-secret" || providerData.accountname != "test-account" {
+		t.Errorf("Expected the command credentials, got %+v", providerData)
+	}
+
+	providerData.apiKey = "fake_api_key"
+	if err = applyCommandCredentials(&providerData); err == nil {
+		t.Error("Expected an error when credentials_command is used together with api_key")
+	}
+}
+
+func TestValidateProviderConfig(t *testing.T) {
+	t.Setenv(constants.EnvURL, "https://example.com/BeyondTrust/api/public/v3")
+	t.Setenv(constants.EnvClientSecret, constants.FakeClientSecret)
+
+	// client_secret comes from the environment, client_id is only known after apply.
+	diags := validateProviderConfig(newProviderConfig(ProviderModel{
+		ClientId:   types.StringUnknown(),
+		APIVersion: types.StringValue("3.1"),
+	}))
+	if diags.HasError() {
+		t.Errorf("Expected no errors, got %v", diags)
+	}
+
+	diags = validateProviderConfig(newProviderConfig(ProviderModel{
+		APIKey:     types.StringValue("fake_api_key"),
+		APIVersion: types.StringValue("3"),
+	}))
+	for _, attribute := range []string{"api_key", "api_account_name", "api_version"} {
+		if diags.ErrorsCount() == 0 || !containsAttributeError(diags, path.Root(attribute)) {
+			t.Errorf("Expected an error on %v, got %v", attribute, diags)
+		}
+	}
+}
+
+func TestValidateProviderConfigRequireCredentials(t *testing.T) {
+	config := newProviderConfig(ProviderModel{})
+	if diags := validateProviderConfig(config); diags.HasError() {
+		t.Errorf("Missing credentials should not fail terraform validate, got %v", diags)
+	}
+
+	config.RequireCredentials = true
+	diags := validateProviderConfig(config)
+	if !containsAttributeError(diags, path.Root("url")) || diags.ErrorsCount() != 2 {
+		t.Errorf("Expected missing url and credentials errors, got %v", diags)
+	}
+}
+
+func containsAttributeError(diags diag.Diagnostics, attributePath path.Path) bool {
+	for _, diagnostic := range diags.Errors() {
+		if withPath, ok := diagnostic.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(attributePath) {
+			return true
+		}
+	}
+	return false
+}
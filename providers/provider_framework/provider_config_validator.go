@@ -0,0 +1,84 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/constants"
+	localutils "terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ provider.ConfigValidator = providerConfigValidator{}
+
+// providerConfigValidator runs the provider configuration checks shared with the SDKv2 provider during
+// ValidateProviderConfig. Only the framework provider runs it, the mux server returns the diagnostics of
+// both providers.
+type providerConfigValidator struct{}
+
+func (v providerConfigValidator) Description(ctx context.Context) string {
+	return "checks the credentials, url and api_version of the provider configuration"
+}
+
+func (v providerConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v providerConfigValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data ProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProviderConfig(newProviderConfig(data))...)
+}
+
+// newProviderConfig returns the values checked by the shared validation, falling back to the PS_* environment
+// variables like Configure does.
+func newProviderConfig(data ProviderModel) localutils.ProviderConfig {
+	attributes := map[string]struct {
+		value  types.String
+		envVar string
+	}{
+		"api_key":                         {data.APIKey, constants.EnvAPIKey},
+		"client_id":                       {data.ClientId, constants.EnvClientID},
+		"client_secret":                   {data.ClientSecret, constants.EnvClientSecret},
+		"url":                             {data.Url, constants.EnvURL},
+		"api_version":                     {data.APIVersion, constants.EnvAPIVersion},
+		"api_account_name":                {data.APIAccountName, constants.EnvAPIAccountName},
+		"client_certificate_name":         {data.ClientCertificateName, constants.EnvClientCertificateName},
+		"client_certificates_folder_path": {data.ClientCertificatesFolderPath, constants.EnvClientCertificatesPath},
+		"credentials_command":             {data.CredentialsCommand, constants.EnvCredentialsCommand},
+	}
+
+	config := localutils.NewProviderConfig()
+	for attribute, setting := range attributes {
+		if setting.value.IsUnknown() {
+			config.SetUnknown(attribute)
+			continue
+		}
+		config.Set(attribute, stringOrEnv(setting.value, setting.envVar))
+	}
+	return config
+}
+
+// validateProviderConfig returns the shared validation errors as diagnostics of their attributes.
+func validateProviderConfig(config localutils.ProviderConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, configError := range localutils.ValidateProviderConfig(config) {
+		if configError.Attribute == "" {
+			diags.AddError(configError.Summary, configError.Detail)
+			continue
+		}
+		diags.AddAttributeError(path.Root(configError.Attribute), configError.Summary, configError.Detail)
+	}
+	return diags
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// int32IdentitySchema returns the identity schema of a resource identified by the Password Safe ID in idAttribute.
+func int32IdentitySchema(idAttribute string, description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			idAttribute: identityschema.Int32Attribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setInt32Identity sets the identity of a resource to its Password Safe ID.
+func setInt32Identity(ctx context.Context, identity *tfsdk.ResourceIdentity, idAttribute string, id types.Int32) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.SetAttribute(ctx, path.Root(idAttribute), id)
+}
+
+// readInt32Identity sets the identity of a resource from the ID in its state, states created by previous
+// versions of the provider have no identity.
+func readInt32Identity(ctx context.Context, idAttribute string, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if resp.Identity == nil {
+		return
+	}
+
+	var id types.Int32
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(idAttribute), &id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, idAttribute, id)...)
+}
+
+// importStateByInt32ID imports a resource by the Password Safe ID in the import ID or in the import identity.
+func importStateByInt32ID(ctx context.Context, idAttribute string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var id types.Int32
+
+	if req.ID != "" {
+		value, err := strconv.ParseInt(req.ID, 10, 32)
+		if err != nil || value < 1 {
+			resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected a positive %v, got: %q", idAttribute, req.ID))
+			return
+		}
+		id = types.Int32Value(int32(value))
+	} else {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(idAttribute), &id)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), id)...)
+	resp.Diagnostics.Append(setInt32Identity(ctx, resp.Identity, idAttribute, id)...)
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithValidateConfig = &RotateManagedAccountAction{}
+var _ action.ActionWithConfigure = &RotateManagedAccountAction{}
+
+// @Action(passwordsafe_rotate_managed_account, name="Rotate Managed Account")
+func NewRotateManagedAccountAction() action.Action {
+	return &RotateManagedAccountAction{}
+}
+
+type RotateManagedAccountAction struct {
+	providerInfo *ProviderData
+}
+
+type RotateManagedAccountActionModel struct {
+	ManagedAccountIDs []types.Int32             `tfsdk:"managed_account_ids"`
+	ManagedAccounts   []ManagedAccountNameModel `tfsdk:"managed_account"`
+	Timeout           types.Int32               `tfsdk:"timeout"`
+	PollInterval      types.Int32               `tfsdk:"poll_interval"`
+}
+
+var defaultChangeTimeoutInSeconds = 600
+
+func (a *RotateManagedAccountAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rotate_managed_account"
+}
+
+func (a *RotateManagedAccountAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Rotate Managed Account Action, queues a password change of each managed account and waits until Password Safe " +
+			"completes it, reporting the outcome of every account. Accounts are selected by managed account ID or by system and account names.",
+
+		Attributes: map[string]schema.Attribute{
+			"managed_account_ids": accountIDsAttribute("IDs of the managed accounts to rotate."),
+			"timeout": schema.Int32Attribute{
+				Description: fmt.Sprintf("Maximum time in seconds to wait for all the password changes to complete (default: %d).", defaultChangeTimeoutInSeconds),
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"poll_interval": schema.Int32Attribute{
+				Description: fmt.Sprintf("Time in seconds between change state checks while waiting for the password changes (default: %d).", defaultPollIntervalInSeconds),
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"managed_account": managedAccountBlock("Managed account to rotate, by system and account names. Can be repeated."),
+		},
+	}
+}
+
+func (a *RotateManagedAccountAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	validateManagedAccountSelection(ctx, req, resp)
+}
+
+func (a *RotateManagedAccountAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	a.providerInfo = &c
+
+}
+
+func (a *RotateManagedAccountAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+
+	var data RotateManagedAccountActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// resolving every account before queuing any change, so a wrong name does not leave a partial rotation
+	managedAccounts, err := getManagedAccounts(a.providerInfo, data.ManagedAccountIDs, data.ManagedAccounts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting managed account", err.Error())
+		return
+	}
+
+	timeout := time.Duration(getInt32OrDefault(data.Timeout, defaultChangeTimeoutInSeconds)) * time.Second
+	pollInterval := time.Duration(getInt32OrDefault(data.PollInterval, defaultPollIntervalInSeconds)) * time.Second
+	deadline := time.Now().Add(timeout)
+
+	queued := a.queueChanges(managedAccounts, resp)
+
+	for _, managedAccount := range queued {
+		a.waitForChange(ctx, managedAccount, time.Until(deadline), pollInterval, resp)
+	}
+
+}
+
+// queueChanges queues the password change of each managed account and returns the accounts that were queued,
+// accounts that could not be queued are reported as errors.
+func (a *RotateManagedAccountAction) queueChanges(managedAccounts []entities.ManagedAccountDetails, resp *action.InvokeResponse) []entities.ManagedAccountDetails {
+	queued := make([]entities.ManagedAccountDetails, 0, len(managedAccounts))
+
+	for _, managedAccount := range managedAccounts {
+		if err := utils.QueueManagedAccountCredentialChange(*a.providerInfo.authenticationObj, managedAccount.ManagedAccountID, zapLogger); err != nil {
+			resp.Diagnostics.AddError("Error rotating managed account", fmt.Sprintf("%v: %v", describeManagedAccount(managedAccount), err.Error()))
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%v: password change queued", describeManagedAccount(managedAccount))})
+		queued = append(queued, managedAccount)
+	}
+	return queued
+}
+
+// waitForChange waits until the password change of the managed account completes and reports its outcome. A change is
+// successful when the last change date of the account moved.
+func (a *RotateManagedAccountAction) waitForChange(ctx context.Context, managedAccount entities.ManagedAccountDetails, timeout time.Duration, pollInterval time.Duration, resp *action.InvokeResponse) {
+	changed, err := utils.WaitForManagedAccountChange(ctx, *a.providerInfo.authenticationObj, managedAccount.ManagedAccountID, timeout, pollInterval, zapLogger)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rotating managed account", fmt.Sprintf("%v: %v", describeManagedAccount(managedAccount), err.Error()))
+		return
+	}
+
+	if changed.LastChangeDate == managedAccount.LastChangeDate {
+		resp.Diagnostics.AddError("Error rotating managed account", fmt.Sprintf("%v: the password change did not complete, last change date is still %q", describeManagedAccount(managedAccount), changed.LastChangeDate))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%v: password changed at %v", describeManagedAccount(managedAccount), changed.LastChangeDate)})
+}
@@ -0,0 +1,142 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var RotateManagedAccountActionConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	action "passwordsafe_rotate_managed_account" "test" {
+	config {
+		managed_account_ids = [10]
+		managed_account {
+		system_name = "server01"
+		account_name = "managed_account_01"
+		}
+		timeout = 10
+		poll_interval = 1
+	}
+	}
+
+	resource "terraform_data" "test" {
+	lifecycle {
+		action_trigger {
+		events  = [after_create]
+		actions = [action.passwordsafe_rotate_managed_account.test]
+		}
+	}
+	}`,
+}
+
+// mockRotateManagedAccountAPI mocks Password Safe API, the password change of managed account 10 completes and the one
+// of managed account 11 ends without changing the last change date.
+func mockRotateManagedAccountAPI(t *testing.T, queued *int32) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/ManagedAccounts":
+			response = `{"SystemId":1,"AccountId":11}`
+
+		case constants.APIPath + "/ManagedAccounts/10":
+			response = `{"ManagedAccountID":10,"ManagedSystemID":1,"AccountName":"admin","IsChanging":false,"ChangeState":0,"LastChangeDate":"2026-10-01T10:00:00"}`
+			if atomic.LoadInt32(queued) > 0 {
+				response = `{"ManagedAccountID":10,"ManagedSystemID":1,"AccountName":"admin","IsChanging":false,"ChangeState":0,"LastChangeDate":"2026-10-18T10:00:00"}`
+			}
+
+		case constants.APIPath + "/ManagedAccounts/11":
+			response = `{"ManagedAccountID":11,"ManagedSystemID":1,"AccountName":"managed_account_01","IsChanging":false,"ChangeState":0,"LastChangeDate":"2026-10-01T10:00:00"}`
+
+		case constants.APIPath + "/ManagedAccounts/10/Credentials/Change", constants.APIPath + "/ManagedAccounts/11/Credentials/Change":
+			atomic.AddInt32(queued, 1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+}
+
+func TestRotateManagedAccountAction(t *testing.T) {
+
+	var queued int32
+	server := mockRotateManagedAccountAPI(t, &queued)
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	RotateManagedAccountActionConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				// managed account 11 is reported as failed, managed account 10 is rotated
+				Config:      utils.TestResourceConfig(RotateManagedAccountActionConfig),
+				ExpectError: regexp.MustCompile(`managed account 11 \(managed_account_01\): the password change did not complete`),
+			},
+		},
+	})
+
+	if atomic.LoadInt32(&queued) != 2 {
+		t.Errorf("Expected both password changes to be queued, got %v", queued)
+	}
+}
+
+func TestRotateManagedAccountActionMissingAccounts(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config: `
+				action "passwordsafe_rotate_managed_account" "test" {
+				config {
+					timeout = 10
+				}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing managed accounts"),
+			},
+		},
+	})
+}
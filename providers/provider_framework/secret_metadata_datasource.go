@@ -0,0 +1,255 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SecretMetadataDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SecretMetadataDataSource{}
+
+func NewSecretMetadataDataSource() datasource.DataSource {
+	return &SecretMetadataDataSource{}
+}
+
+type SecretMetadataDataSource struct {
+	providerInfo *ProviderData
+}
+
+type SecretOwnerModel struct {
+	OwnerID types.Int32  `tfsdk:"owner_id"`
+	Owner   types.String `tfsdk:"owner"`
+	Email   types.String `tfsdk:"email"`
+}
+
+// secretOwnerObjectType is the element type of the owners list, a list of objects is used instead of nested
+// attributes because the provider is served using protocol version 5.
+var secretOwnerObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"owner_id": types.Int32Type,
+		"owner":    types.StringType,
+		"email":    types.StringType,
+	},
+}
+
+type SecretMetadataDataSourceModel struct {
+	ID          types.String       `tfsdk:"id"`
+	Path        types.String       `tfsdk:"path"`
+	Title       types.String       `tfsdk:"title"`
+	Separator   types.String       `tfsdk:"separator"`
+	Type        types.String       `tfsdk:"type"`
+	Description types.String       `tfsdk:"description"`
+	Notes       types.String       `tfsdk:"notes"`
+	OwnerID     types.Int32        `tfsdk:"owner_id"`
+	OwnerType   types.String       `tfsdk:"owner_type"`
+	Owners      []SecretOwnerModel `tfsdk:"owners"`
+	Urls        []types.String     `tfsdk:"urls"`
+	FolderID    types.String       `tfsdk:"folder_id"`
+	FolderName  types.String       `tfsdk:"folder_name"`
+	FolderPath  types.String       `tfsdk:"folder_path"`
+	CreatedOn   types.String       `tfsdk:"created_on"`
+	CreatedBy   types.String       `tfsdk:"created_by"`
+	ModifiedOn  types.String       `tfsdk:"modified_on"`
+	ModifiedBy  types.String       `tfsdk:"modified_by"`
+}
+
+func (d *SecretMetadataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_metadata"
+}
+
+func (d *SecretMetadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Secret Metadata Datasource, gets a secret by ID or by path and title without the secret value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Secret ID (GUID), set it to get the secret by ID",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Folder path of the secret, set it with title to get the secret by path",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1792),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Secret title, set it with path to get the secret by path",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Separator of the folder path (default: /)",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Secret type: Credential, Text or File",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description",
+				Computed:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Notes",
+				Computed:    true,
+			},
+			"owner_id": schema.Int32Attribute{
+				Description: "Owner ID",
+				Computed:    true,
+			},
+			"owner_type": schema.StringAttribute{
+				Description: "Owner type: User or Group",
+				Computed:    true,
+			},
+			"owners": schema.ListAttribute{
+				Description: "Secret owners, each one with owner_id, owner (owner name) and email",
+				Computed:    true,
+				ElementType: secretOwnerObjectType,
+			},
+			"urls": schema.ListAttribute{
+				Description: "Secret URLs",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "Folder ID (GUID)",
+				Computed:    true,
+			},
+			"folder_name": schema.StringAttribute{
+				Description: "Folder name",
+				Computed:    true,
+			},
+			"folder_path": schema.StringAttribute{
+				Description: "Folder path",
+				Computed:    true,
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation date (ISO 8601 format)",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "User that created the secret",
+				Computed:    true,
+			},
+			"modified_on": schema.StringAttribute{
+				Description: "Last modification date (ISO 8601 format)",
+				Computed:    true,
+			},
+			"modified_by": schema.StringAttribute{
+				Description: "User that last modified the secret",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SecretMetadataDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("title"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("path"),
+			path.MatchRoot("title"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("separator"),
+		),
+	}
+}
+
+func (d *SecretMetadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	d.providerInfo = &c
+
+	if d.providerInfo.userName == "" {
+		return
+	}
+
+}
+
+func (d *SecretMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretMetadataDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secret entities.SecretMetadata
+	var err error
+
+	if !data.ID.IsNull() {
+		secret, err = utils.GetSecretMetadata(*d.providerInfo.authenticationObj, data.ID.ValueString(), zapLogger)
+	} else {
+		secret, err = utils.GetSecretMetadataByPath(*d.providerInfo.authenticationObj, data.Path.ValueString(), data.Title.ValueString(), getSeparator(data.Separator), zapLogger)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting secret metadata", err.Error())
+		return
+	}
+
+	setSecretMetadataModel(&data, secret)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+}
+
+// setSecretMetadataModel sets the computed attributes of the data source from the secret metadata.
+func setSecretMetadataModel(data *SecretMetadataDataSourceModel, secret entities.SecretMetadata) {
+	data.ID = types.StringValue(secret.Id)
+	data.Title = types.StringValue(secret.Title)
+	data.Type = types.StringValue(secret.SecretType)
+	data.Description = types.StringValue(secret.Description)
+	data.Notes = types.StringValue(secret.Notes)
+	data.OwnerID = types.Int32Value(int32(secret.OwnerId))
+	data.OwnerType = types.StringValue(secret.OwnerType)
+	data.FolderID = types.StringValue(secret.FolderId)
+	data.FolderName = types.StringValue(secret.Folder)
+	data.FolderPath = types.StringValue(secret.FolderPath)
+	data.CreatedOn = types.StringValue(secret.CreatedOn)
+	data.CreatedBy = types.StringValue(secret.CreatedBy)
+	data.ModifiedOn = types.StringValue(secret.ModifiedOn)
+	data.ModifiedBy = types.StringValue(secret.ModifiedBy)
+
+	data.Owners = []SecretOwnerModel{}
+	for _, owner := range secret.Owners {
+		data.Owners = append(data.Owners, SecretOwnerModel{
+			OwnerID: types.Int32Value(int32(owner.OwnerId)),
+			Owner:   types.StringValue(owner.Owner),
+			Email:   types.StringValue(owner.Email),
+		})
+	}
+
+	data.Urls = []types.String{}
+	for _, secretUrl := range secret.Urls {
+		data.Urls = append(data.Urls, types.StringValue(secretUrl.Url))
+	}
+}
@@ -0,0 +1,208 @@
+package provider_framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var secretMetadataConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+		data "passwordsafe_secret_metadata" "by_path" {
+		path  = "folder1"
+		title = "credential"
+		}
+
+		data "passwordsafe_secret_metadata" "by_id" {
+		id = "9152f5b6-07d6-4955-175a-08db047219ce"
+		}`,
+}
+
+// newSecretMetadataMockServer mocks the secret lookup endpoints, the secret value is returned as the API does.
+func newSecretMetadataMockServer(t *testing.T) *httptest.Server {
+	secret := `{"Id": "9152f5b6-07d6-4955-175a-08db047219ce", "Title": "credential", "SecretType": "Credential", "Password": "password",
+		"FolderId": "cb871861-8b40-4556-820c-1ca6d522adfa", "Folder": "folder1", "FolderPath": "folder1", "OwnerId": 1, "OwnerType": "User",
+		"Owners": [{"OwnerId": 1, "Owner": "admin", "Email": "admin@beyondtrust.com"}], "Urls": [{"Id": "1", "Url": "https://server01"}],
+		"CreatedOn": "2025-05-01T10:00:00", "CreatedBy": "admin", "ModifiedOn": "2025-05-02T10:00:00", "ModifiedBy": "admin"}`
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/secrets-safe/secrets":
+			if r.URL.Query().Get("decrypt") != "false" {
+				t.Errorf("secret should not be decrypted, got %v", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`[` + secret + `]`))
+
+		case constants.APIPath + "/secrets-safe/secrets/9152f5b6-07d6-4955-175a-08db047219ce":
+			if r.URL.Query().Get("decrypt") != "false" {
+				t.Errorf("secret should not be decrypted, got %v", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(secret))
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestSecretMetadataDataSource(t *testing.T) {
+
+	server := newSecretMetadataMockServer(t)
+
+	server.URL = server.URL + constants.APIPath
+	secretMetadataConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: utils.TestResourceConfig(secretMetadataConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_metadata.by_path",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("9152f5b6-07d6-4955-175a-08db047219ce"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_metadata.by_path",
+						tfjsonpath.New("owners"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_metadata.by_path",
+						tfjsonpath.New("urls"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("https://server01")}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_metadata.by_id",
+						tfjsonpath.New("folder_path"),
+						knownvalue.StringExact("folder1"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_metadata.by_id",
+						tfjsonpath.New("title"),
+						knownvalue.StringExact("credential"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestSecretMetadataDataSourceNotFound(t *testing.T) {
+
+	server := newSecretMetadataMockServer(t)
+
+	server.URL = server.URL + constants.APIPath
+
+	config := secretMetadataConfig
+	config.URL = server.URL
+	config.Resource = `
+		data "passwordsafe_secret_metadata" "by_path" {
+		path  = "folder1"
+		title = "unknown"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      utils.TestResourceConfig(config),
+				ExpectError: regexp.MustCompile("Error getting secret metadata"),
+			},
+		},
+	})
+}
+
+func TestSecretMetadataDataSourceMetadata(t *testing.T) {
+	ds := NewSecretMetadataDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "passwordsafe",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "passwordsafe_secret_metadata" {
+		t.Errorf("Expected TypeName 'passwordsafe_secret_metadata', got '%s'", resp.TypeName)
+	}
+}
+
+func TestSecretMetadataDataSourceSchema(t *testing.T) {
+	ds := NewSecretMetadataDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	for _, name := range []string{"value", "password", "file_content"} {
+		if _, ok := resp.Schema.Attributes[name]; ok {
+			t.Errorf("secret metadata datasource should not expose %v", name)
+		}
+	}
+
+	for name, attribute := range resp.Schema.Attributes {
+		if attribute.IsSensitive() {
+			t.Errorf("secret metadata datasource should not have sensitive attributes, got %v", name)
+		}
+	}
+}
+
+func TestSetSecretMetadataModel(t *testing.T) {
+	var data SecretMetadataDataSourceModel
+
+	setSecretMetadataModel(&data, entities.SecretMetadata{
+		SecretSummary: entities.SecretSummary{Id: "9152f5b6-07d6-4955-175a-08db047219ce", Title: "credential", SecretType: "Credential"},
+		Urls:          []entities.SecretUrl{{Id: "1", Url: "https://server01"}},
+	})
+
+	if data.ID.ValueString() != "9152f5b6-07d6-4955-175a-08db047219ce" || data.Type.ValueString() != "Credential" {
+		t.Errorf("Expected the secret attributes, got %+v", data)
+	}
+	if data.Owners == nil || len(data.Owners) != 0 {
+		t.Errorf("Expected an empty owners list, got %v", data.Owners)
+	}
+	if len(data.Urls) != 1 || data.Urls[0].ValueString() != "https://server01" {
+		t.Errorf("Expected the secret URLs, got %v", data.Urls)
+	}
+}
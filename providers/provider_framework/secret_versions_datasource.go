@@ -0,0 +1,156 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SecretVersionsDataSource{}
+
+func NewSecretVersionsDataSource() datasource.DataSource {
+	return &SecretVersionsDataSource{}
+}
+
+type SecretVersionsDataSource struct {
+	providerInfo *ProviderData
+}
+
+type SecretVersionModel struct {
+	Version    types.Int32  `tfsdk:"version"`
+	ModifiedOn types.String `tfsdk:"modified_on"`
+	ModifiedBy types.String `tfsdk:"modified_by"`
+}
+
+// secretVersionObjectType is the element type of the versions list, a list of objects is used instead of nested
+// attributes because the provider is served using protocol version 5.
+var secretVersionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"version":     types.Int32Type,
+		"modified_on": types.StringType,
+		"modified_by": types.StringType,
+	},
+}
+
+type SecretVersionsDataSourceModel struct {
+	Path      types.String         `tfsdk:"path"`
+	Title     types.String         `tfsdk:"title"`
+	Separator types.String         `tfsdk:"separator"`
+	SecretID  types.String         `tfsdk:"secret_id"`
+	Versions  []SecretVersionModel `tfsdk:"versions"`
+}
+
+func (d *SecretVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_versions_datasource"
+}
+
+func (d *SecretVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Secret Versions Datasource, gets the versions of a secret without the secret values.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Secret path",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1792),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Secret title",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Separator",
+				Optional:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "Secret ID (GUID)",
+				Computed:    true,
+			},
+			"versions": schema.ListAttribute{
+				Description: "Secret versions, each one with version (version number), modified_on (date and time the version was created) and modified_by (user that created the version)",
+				Computed:    true,
+				ElementType: secretVersionObjectType,
+			},
+		},
+	}
+}
+
+func (d *SecretVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	d.providerInfo = &c
+
+	if d.providerInfo.userName == "" {
+		return
+	}
+
+}
+
+func (d *SecretVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretID, versions, err := getSecretVersions(*d.providerInfo, data.Path, data.Title, data.Separator)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting secret versions", err.Error())
+		return
+	}
+
+	data.SecretID = types.StringValue(secretID)
+	data.Versions = versions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+}
+
+// getSecretVersions gets the secret ID and the versions of a secret by path and title.
+func getSecretVersions(providerInfo ProviderData, secretPath types.String, title types.String, separator types.String) (string, []SecretVersionModel, error) {
+	secretID, err := utils.GetSecretID(*providerInfo.authenticationObj, secretPath.ValueString(), title.ValueString(), getSeparator(separator), zapLogger)
+	if err != nil {
+		return "", nil, err
+	}
+
+	items, err := utils.GetSecretVersions(*providerInfo.authenticationObj, secretID, zapLogger)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return secretID, toSecretVersionModels(items), nil
+}
+
+// toSecretVersionModels converts secret versions API data to terraform models.
+func toSecretVersionModels(items []entities.SecretVersion) []SecretVersionModel {
+	versions := []SecretVersionModel{}
+
+	for _, item := range items {
+		versions = append(versions, SecretVersionModel{
+			Version:    types.Int32Value(int32(item.Version)),
+			ModifiedOn: types.StringValue(item.ModifiedOn),
+			ModifiedBy: types.StringValue(item.ModifiedBy),
+		})
+	}
+
+	return versions
+}
@@ -0,0 +1,168 @@
+package provider_framework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var secretVersionsConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+		data "passwordsafe_secret_versions_datasource" "versions" {
+		path  = "folder1"
+		title = "credential"
+		}`,
+}
+
+// newSecretVersionsMockServer mocks the secret lookup and secret versions endpoints.
+func newSecretVersionsMockServer(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/secrets-safe/secrets":
+			_, _ = w.Write([]byte(`[{"Id": "9152f5b6-07d6-4955-175a-08db047219ce", "Title": "credential"}]`))
+
+		case constants.APIPath + "/secrets-safe/secrets/9152f5b6-07d6-4955-175a-08db047219ce/versions":
+			_, _ = w.Write([]byte(`[{"Version": 2, "ModifiedOn": "2025-05-02T10:00:00", "ModifiedBy": "admin"}, {"Version": 1, "ModifiedOn": "2025-05-01T10:00:00", "ModifiedBy": "admin"}]`))
+
+		case constants.APIPath + "/secrets-safe/secrets/9152f5b6-07d6-4955-175a-08db047219ce/versions/1":
+			_, _ = w.Write([]byte(`{"Version": 1, "ModifiedOn": "2025-05-01T10:00:00", "ModifiedBy": "admin", "SecretType": "Credential", "Password": "old_password"}`))
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestSecretVersionsDataSource(t *testing.T) {
+
+	server := newSecretVersionsMockServer(t)
+
+	server.URL = server.URL + constants.APIPath
+	secretVersionsConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: utils.TestResourceConfig(secretVersionsConfig),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_versions_datasource.versions",
+						tfjsonpath.New("versions"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.passwordsafe_secret_versions_datasource.versions",
+						tfjsonpath.New("secret_id"),
+						knownvalue.StringExact("9152f5b6-07d6-4955-175a-08db047219ce"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestSecretVersionsDataSourceNotFound(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`))
+
+		case constants.APIPath + "/Auth/Signout":
+			_, _ = w.Write([]byte(``))
+
+		case constants.APIPath + "/secrets-safe/secrets":
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+
+	server.URL = server.URL + constants.APIPath
+	secretVersionsConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      utils.TestResourceConfig(secretVersionsConfig),
+				ExpectError: regexp.MustCompile("Error getting secret versions"),
+			},
+		},
+	})
+}
+
+func TestSecretVersionsDataSourceMetadata(t *testing.T) {
+	ds := NewSecretVersionsDataSource()
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "passwordsafe",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "passwordsafe_secret_versions_datasource" {
+		t.Errorf("Expected TypeName 'passwordsafe_secret_versions_datasource', got '%s'", resp.TypeName)
+	}
+}
+
+func TestSecretVersionsDataSourceSchema(t *testing.T) {
+	ds := NewSecretVersionsDataSource()
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	if _, ok := resp.Schema.Attributes["value"]; ok {
+		t.Error("secret versions datasource should not expose secret values")
+	}
+
+	if !resp.Schema.Attributes["versions"].IsComputed() {
+		t.Error("versions attribute should be computed")
+	}
+}
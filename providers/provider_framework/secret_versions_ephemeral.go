@@ -0,0 +1,138 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralSecretVersions{}
+
+// @EphemeralResource(passwordsafe_secret_versions_ephemeral, name="Secret Versions")
+func NewEphemeralSecretVersions() ephemeral.EphemeralResource {
+	return &EphemeralSecretVersions{}
+}
+
+type EphemeralSecretVersions struct {
+	providerInfo *ProviderData
+}
+
+type EphemeralSecretVersionsModel struct {
+	Path      types.String         `tfsdk:"path"`
+	Title     types.String         `tfsdk:"title"`
+	Separator types.String         `tfsdk:"separator"`
+	Version   types.Int32          `tfsdk:"version"`
+	SecretID  types.String         `tfsdk:"secret_id"`
+	Versions  []SecretVersionModel `tfsdk:"versions"`
+	Value     types.String         `tfsdk:"value"`
+}
+
+func (e *EphemeralSecretVersions) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_secret_versions_ephemeral"
+}
+
+func (e *EphemeralSecretVersions) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+
+		MarkdownDescription: "Secret Versions Ephemeral Resource, gets the versions of a secret and the value of a specific version.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Secret path",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1792),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Secret title",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Separator",
+				Optional:    true,
+			},
+			"version": schema.Int32Attribute{
+				Description: "Version number whose value is returned in the value attribute",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "Secret ID (GUID)",
+				Computed:    true,
+			},
+			"versions": schema.ListAttribute{
+				Description: "Secret versions, each one with version (version number), modified_on (date and time the version was created) and modified_by (user that created the version)",
+				Computed:    true,
+				ElementType: secretVersionObjectType,
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the requested version, the password of a credential secret or the text of a text secret. Versions of file secrets are not supported.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *EphemeralSecretVersions) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	e.providerInfo = &c
+
+	if e.providerInfo.userName == "" {
+		return
+	}
+
+}
+
+func (e *EphemeralSecretVersions) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+
+	var data EphemeralSecretVersionsModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	secretID, versions, err := getSecretVersions(*e.providerInfo, data.Path, data.Title, data.Separator)
+
+	if err != nil {
+		response.Diagnostics.AddError("Error getting secret versions", err.Error())
+		return
+	}
+
+	data.SecretID = types.StringValue(secretID)
+	data.Versions = versions
+	data.Value = types.StringNull()
+
+	if !data.Version.IsNull() {
+		// getting the value of the requested version
+		value, err := utils.GetSecretVersionValue(*e.providerInfo.authenticationObj, secretID, int(data.Version.ValueInt32()), zapLogger)
+		if err != nil {
+			response.Diagnostics.AddError("Error getting secret version", err.Error())
+			return
+		}
+		data.Value = types.StringValue(value)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+
+}
@@ -5,6 +5,8 @@ package provider_framework
 import (
 	"context"
 
+	"terraform-provider-passwordsafe/providers/utils"
+
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/secrets"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -117,8 +119,10 @@ func (e *EphemeralSecret) Open(ctx context.Context, request ephemeral.OpenReques
 		sep = data.Separator.ValueString()
 	}
 
-	// getting single secret from PS API
-	secret, err := secretObj.GetSecret(data.Path.ValueString()+sep+data.Title.ValueString(), sep)
+	// getting single secret from PS API, or from the credential cache when enabled
+	secret, err := utils.GetCachedCredential(e.providerInfo.authenticationObj, utils.SecretCacheKey(data.Path.ValueString(), data.Title.ValueString(), sep, decryptValue), func() (string, error) {
+		return secretObj.GetSecret(data.Path.ValueString()+sep+data.Title.ValueString(), sep)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("Error getting secret", err.Error())
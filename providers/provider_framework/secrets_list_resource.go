@@ -0,0 +1,147 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &secretListResource{}
+var _ list.ListResourceWithRawV5Schemas = &secretListResource{}
+
+// NewCredentialSecretListResource lists credential secrets.
+func NewCredentialSecretListResource() list.ListResource {
+	return &secretListResource{
+		typeName:   "passwordsafe_credential_secret",
+		secretType: "Credential",
+		resourceAttributes: func(item entities.SecretSummary) map[string]any {
+			return map[string]any{"username": item.Username}
+		},
+	}
+}
+
+// NewTextSecretListResource lists text secrets.
+func NewTextSecretListResource() list.ListResource {
+	return &secretListResource{
+		typeName:   "passwordsafe_text_secret",
+		secretType: "Text",
+	}
+}
+
+// NewFileSecretListResource lists file secrets.
+func NewFileSecretListResource() list.ListResource {
+	return &secretListResource{
+		typeName:   "passwordsafe_file_secret",
+		secretType: "File",
+	}
+}
+
+// secretListResource lists the secrets of secretType, resourceAttributes returns the resource attributes
+// specific to the secret type.
+type secretListResource struct {
+	listResource
+	typeName           string
+	secretType         string
+	resourceAttributes func(item entities.SecretSummary) map[string]any
+}
+
+type SecretListResourceModel struct {
+	FolderPath  types.String `tfsdk:"folder_path"`
+	Separator   types.String `tfsdk:"separator"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+}
+
+func (l *secretListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.typeName
+}
+
+func (l *secretListResource) RawV5Schemas(ctx context.Context, req list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkv2RawV5Schemas(l.typeName, resp)
+}
+
+func (l *secretListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Secret list resource, lists " + strings.ToLower(l.secretType) + " secrets. Secret values are not listed.",
+		Attributes: map[string]schema.Attribute{
+			"folder_path": schema.StringAttribute{
+				Description: "Lists only the secrets of the folder and of its subfolders, for example folder1/folder2.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Separator of the folder path (default: /).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1),
+				},
+			},
+			"name_pattern": namePatternAttribute("Secret title pattern."),
+		},
+	}
+}
+
+func (l *secretListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data SecretListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	separator := data.Separator.ValueString()
+	if data.Separator.IsNull() {
+		separator = "/"
+	}
+
+	items, err := utils.GetSecretsList(*l.providerInfo.authenticationObj, data.FolderPath.ValueString(), separator, zapLogger)
+	if err != nil {
+		diags.AddError("Error getting secrets list", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pattern := utils.NewNamePattern(data.NamePattern.ValueString())
+
+	var filtered []entities.SecretSummary
+	for _, item := range items {
+		if strings.EqualFold(item.SecretType, l.secretType) && pattern.Match(item.Title) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	streamListResults(ctx, req, stream, filtered, func(item entities.SecretSummary, result *list.ListResult) {
+		result.DisplayName = item.FolderPath + separator + item.Title
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), item.Id)...)
+		if req.IncludeResource {
+			attributes := map[string]any{
+				"id":          item.Id,
+				"folder_name": item.Folder,
+				"title":       item.Title,
+				"description": item.Description,
+				"notes":       item.Notes,
+			}
+			if l.resourceAttributes != nil {
+				maps.Copy(attributes, l.resourceAttributes(item))
+			}
+			for name, value := range attributes {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var SecretListResourceConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	list "passwordsafe_credential_secret" "credentials" {
+	provider = passwordsafe
+	config {
+		folder_path = "folder1"
+	}
+	}
+
+	list "passwordsafe_text_secret" "tokens" {
+	provider = passwordsafe
+	config {
+		folder_path = "folder1"
+		name_pattern = "*token*"
+	}
+	}
+
+	list "passwordsafe_file_secret" "files" {
+	provider = passwordsafe
+	config {
+		folder_path = "folder1"
+	}
+	}`,
+}
+
+func TestSecretListResource(t *testing.T) {
+
+	// mocking Password Safe API
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/secrets-safe/secrets":
+			response = `[
+				{"Id":"9152f5b6-07d6-4955-175a-08db047219ce","Title":"database","SecretType":"Credential","Folder":"folder1","Username":"admin"},
+				{"Id":"7c3ee5ab-3f6b-4a1e-9c7c-08db047219cf","Title":"api token","SecretType":"Text","Folder":"folder1"},
+				{"Id":"2d4a1c8f-5e6b-4c3d-8a7b-08db047219d0","Title":"notes","SecretType":"Text","Folder":"folder1"}
+			]`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	SecretListResourceConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() {},
+		ProtoV5ProviderFactories: muxProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+
+			{
+				Query:  true,
+				Config: utils.TestResourceConfig(SecretListResourceConfig),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("passwordsafe_credential_secret.credentials", 1),
+					querycheck.ExpectIdentity("passwordsafe_credential_secret.credentials", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact("9152f5b6-07d6-4955-175a-08db047219ce"),
+					}),
+					querycheck.ExpectLength("passwordsafe_text_secret.tokens", 1),
+					querycheck.ExpectLength("passwordsafe_file_secret.files", 0),
+				},
+			},
+		},
+	})
+}
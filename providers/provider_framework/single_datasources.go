@@ -0,0 +1,133 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// singleDataSource holds the provider data of data sources that get a single object.
+type singleDataSource struct {
+	providerInfo *ProviderData
+}
+
+func (d *singleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	d.providerInfo = &c
+
+}
+
+// singleDataSourceAttributes returns the attributes of a data source that gets a single object from the attributes
+// of the object in the list data source, all of them are computed and the filters are optional too.
+func singleDataSourceAttributes(attributes map[string]schema.Attribute, filters []string) map[string]schema.Attribute {
+	singleAttributes := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		description := attribute.GetMarkdownDescription()
+		isFilter := slices.Contains(filters, name)
+
+		switch attribute.GetType() {
+		case types.StringType:
+			singleAttributes[name] = schema.StringAttribute{MarkdownDescription: description, Optional: isFilter, Computed: true}
+		case types.Int32Type, types.Int64Type:
+			// utils.GetInt32Attribute returns int64 attributes, the models of the objects have int32 values
+			singleAttributes[name] = schema.Int32Attribute{MarkdownDescription: description, Optional: isFilter, Computed: true}
+		case types.BoolType:
+			singleAttributes[name] = schema.BoolAttribute{MarkdownDescription: description, Optional: isFilter, Computed: true}
+		}
+	}
+
+	return singleAttributes
+}
+
+// singleDataSourceConfigValidators returns the validators of a data source that gets a single object, at least one
+// filter must be set.
+func singleDataSourceConfigValidators(filters []string) []datasource.ConfigValidator {
+	expressions := make([]path.Expression, 0, len(filters))
+	for _, filter := range filters {
+		expressions = append(expressions, path.MatchRoot(filter))
+	}
+
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(expressions...),
+	}
+}
+
+// lookupFilter is a filter of a data source that gets a single object, the filter applies when its value is set.
+type lookupFilter[T any] struct {
+	attribute string
+	value     attr.Value
+	match     func(item T) bool
+}
+
+// int32Filter returns a filter matching the items whose field equals value.
+func int32Filter[T any](attribute string, value types.Int32, field func(item T) int) lookupFilter[T] {
+	return lookupFilter[T]{
+		attribute: attribute,
+		value:     value,
+		match: func(item T) bool {
+			return field(item) == int(value.ValueInt32())
+		},
+	}
+}
+
+// stringFilter returns a filter matching the items whose field equals value, case insensitive as names in
+// Password Safe are.
+func stringFilter[T any](attribute string, value types.String, field func(item T) string) lookupFilter[T] {
+	return lookupFilter[T]{
+		attribute: attribute,
+		value:     value,
+		match: func(item T) bool {
+			return strings.EqualFold(field(item), value.ValueString())
+		},
+	}
+}
+
+// findSingle returns the only item matching the filters set, or an error when no item or more than one item
+// matches.
+func findSingle[T any](objectName string, items []T, filters ...lookupFilter[T]) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matches []T
+	var description []string
+
+	for _, filter := range filters {
+		if !filter.value.IsNull() {
+			description = append(description, fmt.Sprintf("%v = %v", filter.attribute, filter.value))
+		}
+	}
+
+	for _, item := range items {
+		if !slices.ContainsFunc(filters, func(filter lookupFilter[T]) bool {
+			return !filter.value.IsNull() && !filter.match(item)
+		}) {
+			matches = append(matches, item)
+		}
+	}
+
+	var item T
+	switch len(matches) {
+	case 0:
+		diags.AddError(fmt.Sprintf("No %v found", objectName), fmt.Sprintf("No %v matches %v.", objectName, strings.Join(description, ", ")))
+	case 1:
+		item = matches[0]
+	default:
+		diags.AddError(fmt.Sprintf("Multiple %vs found", objectName),
+			fmt.Sprintf("%v %vs match %v, set more filters so that only one matches.", len(matches), objectName, strings.Join(description, ", ")))
+	}
+
+	return item, diags
+}
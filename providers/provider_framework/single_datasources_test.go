@@ -0,0 +1,57 @@
+package provider_framework
+
+import (
+	"testing"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFindSingle(t *testing.T) {
+	items := []entities.WorkGroupResponse{
+		{ID: 1, Name: "Default Workgroup"},
+		{ID: 2, Name: "Duplicated"},
+		{ID: 3, Name: "duplicated"},
+	}
+
+	filters := func(id types.Int32, name types.String) []lookupFilter[entities.WorkGroupResponse] {
+		return []lookupFilter[entities.WorkGroupResponse]{
+			int32Filter("id", id, func(item entities.WorkGroupResponse) int { return item.ID }),
+			stringFilter("name", name, func(item entities.WorkGroupResponse) string { return item.Name }),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		id         types.Int32
+		workgroup  types.String
+		expectedID int
+		error      string
+	}{
+		{name: "by id", id: types.Int32Value(2), workgroup: types.StringNull(), expectedID: 2},
+		{name: "by name, case insensitive", id: types.Int32Null(), workgroup: types.StringValue("DEFAULT WORKGROUP"), expectedID: 1},
+		{name: "by id and name", id: types.Int32Value(3), workgroup: types.StringValue("duplicated"), expectedID: 3},
+		{name: "no match", id: types.Int32Value(1), workgroup: types.StringValue("duplicated"), error: "No workgroup found"},
+		{name: "multiple matches", id: types.Int32Null(), workgroup: types.StringValue("duplicated"), error: "Multiple workgroups found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			item, diags := findSingle("workgroup", items, filters(test.id, test.workgroup)...)
+
+			if test.error != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != test.error {
+					t.Errorf("expected error %q, got %v", test.error, diags)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if item.ID != test.expectedID {
+				t.Errorf("expected workgroup %v, got %v", test.expectedID, item.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigValidators = &TerminateRequestsAction{}
+var _ action.ActionWithConfigure = &TerminateRequestsAction{}
+
+// @Action(passwordsafe_terminate_requests, name="Terminate Requests")
+func NewTerminateRequestsAction() action.Action {
+	return &TerminateRequestsAction{}
+}
+
+type TerminateRequestsAction struct {
+	providerInfo *ProviderData
+}
+
+type TerminateRequestsActionModel struct {
+	ManagedAccountID types.Int32  `tfsdk:"managed_account_id"`
+	ManagedSystemID  types.Int32  `tfsdk:"managed_system_id"`
+	UserID           types.Int32  `tfsdk:"user_id"`
+	Reason           types.String `tfsdk:"reason"`
+}
+
+func (a *TerminateRequestsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminate_requests"
+}
+
+func (a *TerminateRequestsAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	idAttribute := func(description string) schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Terminate Requests Action, terminates all the active access requests of a managed account, of the managed accounts " +
+			"of a managed system or of a user, so no open request remains once a system is decommissioned.",
+
+		Attributes: map[string]schema.Attribute{
+			"managed_account_id": idAttribute("ID of the managed account whose requests are terminated."),
+			"managed_system_id":  idAttribute("ID of the managed system whose requests are terminated."),
+			"user_id":            idAttribute("ID of the user whose requests are terminated."),
+			"reason": schema.StringAttribute{
+				Description: "Reason of the termination, recorded in the requests.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+		},
+	}
+}
+
+func (a *TerminateRequestsAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("managed_account_id"),
+			path.MatchRoot("managed_system_id"),
+			path.MatchRoot("user_id"),
+		),
+	}
+}
+
+func (a *TerminateRequestsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	a.providerInfo = &c
+
+}
+
+func (a *TerminateRequestsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+
+	var data TerminateRequestsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, id, description := data.target()
+
+	err := utils.TerminateRequests(*a.providerInfo.authenticationObj, scope, id, data.Reason.ValueString(), zapLogger)
+	if err != nil {
+		resp.Diagnostics.AddError("Error terminating requests", fmt.Sprintf("%v: %v", description, err.Error()))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%v: active requests terminated", description)})
+
+}
+
+// target returns the termination scope, the ID and the description of the managed account, managed system or user set.
+func (data TerminateRequestsActionModel) target() (string, int, string) {
+	switch {
+	case !data.ManagedAccountID.IsNull():
+		return utils.TerminateRequestsManagedAccount, int(data.ManagedAccountID.ValueInt32()), fmt.Sprintf("managed account %v", data.ManagedAccountID.ValueInt32())
+	case !data.ManagedSystemID.IsNull():
+		return utils.TerminateRequestsManagedSystem, int(data.ManagedSystemID.ValueInt32()), fmt.Sprintf("managed system %v", data.ManagedSystemID.ValueInt32())
+	}
+	return utils.TerminateRequestsUser, int(data.UserID.ValueInt32()), fmt.Sprintf("user %v", data.UserID.ValueInt32())
+}
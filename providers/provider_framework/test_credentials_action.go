@@ -0,0 +1,158 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-passwordsafe/providers/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithValidateConfig = &TestCredentialsAction{}
+var _ action.ActionWithConfigure = &TestCredentialsAction{}
+var _ action.ActionWithConfigure = &TestFunctionalAccountCredentialsAction{}
+
+// @Action(passwordsafe_test_credentials, name="Test Credentials")
+func NewTestCredentialsAction() action.Action {
+	return &TestCredentialsAction{}
+}
+
+// @Action(passwordsafe_test_functional_account_credentials, name="Test Functional Account Credentials")
+func NewTestFunctionalAccountCredentialsAction() action.Action {
+	return &TestFunctionalAccountCredentialsAction{}
+}
+
+type TestCredentialsAction struct {
+	providerInfo *ProviderData
+}
+
+type TestFunctionalAccountCredentialsAction struct {
+	providerInfo *ProviderData
+}
+
+type TestCredentialsActionModel struct {
+	ManagedAccountIDs []types.Int32             `tfsdk:"managed_account_ids"`
+	ManagedAccounts   []ManagedAccountNameModel `tfsdk:"managed_account"`
+}
+
+type TestFunctionalAccountCredentialsActionModel struct {
+	FunctionalAccountIDs []types.Int32 `tfsdk:"functional_account_ids"`
+}
+
+func (a *TestCredentialsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_credentials"
+}
+
+func (a *TestCredentialsAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Test Credentials Action, tests whether the stored password of each managed account still works on its target system. " +
+			"Every account is tested and each failure is reported as an error. Accounts are selected by managed account ID or by system and account names.",
+
+		Attributes: map[string]schema.Attribute{
+			"managed_account_ids": accountIDsAttribute("IDs of the managed accounts to test."),
+		},
+		Blocks: map[string]schema.Block{
+			"managed_account": managedAccountBlock("Managed account to test, by system and account names. Can be repeated."),
+		},
+	}
+}
+
+func (a *TestCredentialsAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	validateManagedAccountSelection(ctx, req, resp)
+}
+
+func (a *TestCredentialsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	a.providerInfo = &c
+
+}
+
+func (a *TestCredentialsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+
+	var data TestCredentialsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedAccounts, err := getManagedAccounts(a.providerInfo, data.ManagedAccountIDs, data.ManagedAccounts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting managed account", err.Error())
+		return
+	}
+
+	for _, managedAccount := range managedAccounts {
+		success, err := utils.RunManagedAccountCredentialTest(*a.providerInfo.authenticationObj, managedAccount.ManagedAccountID, zapLogger)
+		reportCredentialTest(describeManagedAccount(managedAccount), success, err, resp)
+	}
+
+}
+
+func (a *TestFunctionalAccountCredentialsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_functional_account_credentials"
+}
+
+func (a *TestFunctionalAccountCredentialsAction) Schema(ctx context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	ids := accountIDsAttribute("IDs of the functional accounts to test.")
+	ids.Optional = false
+	ids.Required = true
+
+	resp.Schema = schema.Schema{
+
+		MarkdownDescription: "Test Functional Account Credentials Action, tests whether the stored password of each functional account still works. " +
+			"Every account is tested and each failure is reported as an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"functional_account_ids": ids,
+		},
+	}
+}
+
+func (a *TestFunctionalAccountCredentialsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+
+	// getting data from Provider
+	c, _ := req.ProviderData.(ProviderData)
+
+	a.providerInfo = &c
+
+}
+
+func (a *TestFunctionalAccountCredentialsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+
+	var data TestFunctionalAccountCredentialsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, functionalAccountID := range data.FunctionalAccountIDs {
+		success, err := utils.RunFunctionalAccountCredentialTest(*a.providerInfo.authenticationObj, int(functionalAccountID.ValueInt32()), zapLogger)
+		reportCredentialTest(fmt.Sprintf("functional account %v", functionalAccountID.ValueInt32()), success, err, resp)
+	}
+
+}
+
+// reportCredentialTest reports the result of the credential test of an account, failed tests are errors.
+func reportCredentialTest(account string, success bool, err error, resp *action.InvokeResponse) {
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddError("Error testing credentials", fmt.Sprintf("%v: %v", account, err.Error()))
+	case !success:
+		resp.Diagnostics.AddError("Credential test failed", fmt.Sprintf("%v: the stored password does not work", account))
+	default:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%v: credential test succeeded", account)})
+	}
+}
@@ -0,0 +1,106 @@
+package provider_framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"terraform-provider-passwordsafe/providers/constants"
+	"terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var TestCredentialsActionConfig entities.PasswordSafeTestConfig = entities.PasswordSafeTestConfig{
+	ClientID:     constants.FakeClientId,
+	ClientSecret: constants.FakeClientSecret,
+	APIVersion:   "3.1",
+	Resource: `
+	action "passwordsafe_test_credentials" "test" {
+	config {
+		managed_account_ids = [10]
+		managed_account {
+		system_name = "server01"
+		account_name = "managed_account_01"
+		}
+	}
+	}
+
+	action "passwordsafe_test_functional_account_credentials" "test" {
+	config {
+		functional_account_ids = [5]
+	}
+	}
+
+	resource "terraform_data" "test" {
+	lifecycle {
+		action_trigger {
+		events  = [after_create]
+		actions = [action.passwordsafe_test_credentials.test, action.passwordsafe_test_functional_account_credentials.test]
+		}
+	}
+	}`,
+}
+
+func TestTestCredentialsAction(t *testing.T) {
+
+	// mocking Password Safe API, the credentials of managed account 11 do not work
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var response string
+
+		// mocking Response according to the endpoint path
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			response = `{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`
+
+		case constants.APIPath + "/Auth/SignAppIn":
+			response = `{"UserId":1, "EmailAddress":"test@beyondtrust.com"}`
+
+		case constants.APIPath + "/ManagedAccounts":
+			response = `{"SystemId":1,"AccountId":11}`
+
+		case constants.APIPath + "/ManagedAccounts/10":
+			response = `{"ManagedAccountID":10,"ManagedSystemID":1,"AccountName":"admin"}`
+
+		case constants.APIPath + "/ManagedAccounts/11":
+			response = `{"ManagedAccountID":11,"ManagedSystemID":1,"AccountName":"managed_account_01"}`
+
+		case constants.APIPath + "/ManagedAccounts/10/Credentials/Test", constants.APIPath + "/FunctionalAccounts/5/Credentials/Test":
+			response = `{"Success":true}`
+
+		case constants.APIPath + "/ManagedAccounts/11/Credentials/Test":
+			response = `{"Success":false}`
+		}
+
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Error(err.Error())
+		}
+	}))
+	defer server.Close()
+
+	server.URL = server.URL + constants.APIPath
+	TestCredentialsActionConfig.URL = server.URL
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"passwordsafe": providerserver.NewProtocol6WithError(NewProvider()),
+		},
+		Steps: []resource.TestStep{
+
+			{
+				Config:      utils.TestResourceConfig(TestCredentialsActionConfig),
+				ExpectError: regexp.MustCompile(`managed account 11 \(managed_account_01\): the stored password does not work`),
+			},
+		},
+	})
+}
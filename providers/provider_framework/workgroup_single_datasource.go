@@ -0,0 +1,75 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package provider_framework implements a terraform provider that can talk with Beyondtrust Secret Safe API.
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/workgroups"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &WorkgroupSingleDataSource{}
+
+// workgroupFilters are the attributes a workgroup is looked up by.
+var workgroupFilters = []string{"id", "name"}
+
+func NewWorkgroupSingleDataSource() datasource.DataSource {
+	return &WorkgroupSingleDataSource{}
+}
+
+type WorkgroupSingleDataSource struct {
+	singleDataSource
+}
+
+func (d *WorkgroupSingleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workgroup"
+}
+
+func (d *WorkgroupSingleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Workgroup Datasource, gets a workgroup by ID or name.",
+		Attributes:  singleDataSourceAttributes(getWorkgroupDataSourceSchemaAttributes(), workgroupFilters),
+	}
+}
+
+func (d *WorkgroupSingleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return singleDataSourceConfigValidators(workgroupFilters)
+}
+
+func (d *WorkgroupSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkgroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// instantiating workgroup obj
+	workgroupObj, _ := workgroups.NewWorkGroupObj(*d.providerInfo.authenticationObj, zapLogger)
+
+	// get workgroups list
+	items, err := workgroupObj.GetWorkgroupListFlow()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting workgroups list", err.Error())
+		return
+	}
+
+	item, diags := findSingle("workgroup", items,
+		int32Filter("id", data.ID, func(item entities.WorkGroupResponse) int { return item.ID }),
+		stringFilter("name", data.Name, func(item entities.WorkGroupResponse) string { return item.Name }),
+	)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newWorkgroupModel(item))...)
+
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/workgroups"
 )
 
@@ -42,26 +43,31 @@ func (d *WorkgroupDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			"workgroups": schema.ListNestedBlock{
 				Description: "Workgroup Datasource Attributes",
 				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"organization_id": schema.StringAttribute{
-							MarkdownDescription: "Organization ID",
-							Required:            true,
-						},
-						"id": schema.Int32Attribute{
-							MarkdownDescription: "ID",
-							Required:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Name",
-							Required:            true,
-						},
-					},
+					Attributes: getWorkgroupDataSourceSchemaAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// getWorkgroupDataSourceSchemaAttributes get schema attributes.
+func getWorkgroupDataSourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "Organization ID",
+			Required:            true,
+		},
+		"id": schema.Int32Attribute{
+			MarkdownDescription: "ID",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name",
+			Required:            true,
+		},
+	}
+}
+
 func (d *WorkgroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 
 	// getting data from Provider
@@ -98,11 +104,7 @@ func (d *WorkgroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 	var workgroupList []WorkgroupModel
 
 	for _, item := range items {
-		workgroupList = append(workgroupList, WorkgroupModel{
-			ID:             types.Int32Value(int32(item.ID)),
-			OrganizationID: types.StringValue(item.OrganizationID),
-			Name:           types.StringValue(item.Name),
-		})
+		workgroupList = append(workgroupList, newWorkgroupModel(item))
 	}
 
 	responseData := WorkgroupDataSourceModel{}
@@ -111,3 +113,12 @@ func (d *WorkgroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
 
 }
+
+// newWorkgroupModel returns the datasource attributes of a workgroup.
+func newWorkgroupModel(item entities.WorkGroupResponse) WorkgroupModel {
+	return WorkgroupModel{
+		ID:             types.Int32Value(int32(item.ID)),
+		OrganizationID: types.StringValue(item.OrganizationID),
+		Name:           types.StringValue(item.Name),
+	}
+}
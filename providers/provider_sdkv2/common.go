@@ -3,9 +3,12 @@
 package provider
 
 import (
+	localutils "terraform-provider-passwordsafe/providers/utils"
+
 	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // providerMeta is what providerConfigure stores in the schema.ResourceData meta
@@ -13,8 +16,9 @@ import (
 // HTTP client (with its cookie jar carrying the session), and signAppin carries
 // the UserName/UserId/EmailAddress used when constructing owner records.
 type providerMeta struct {
-	authObj   *auth.AuthenticationObj
-	signAppin entities.SignAppinResponse
+	authObj    *auth.AuthenticationObj
+	signAppin  entities.SignAppinResponse
+	sessionKey string
 }
 
 // getOwnersSchema get Owners schema.
@@ -198,16 +202,19 @@ func getManagedAccountSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"change_frequency_type": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(localutils.ChangeFrequencyTypes, false),
 		},
 		"change_frequency_days": &schema.Schema{
-			Type:     schema.TypeInt,
-			Optional: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 999),
 		},
 		"change_time": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(localutils.ChangeTimeRegexp, localutils.ChangeTimeMessage),
 		},
 		"next_change_date": &schema.Schema{
 			Type:     schema.TypeString,
@@ -245,3 +252,28 @@ func getManagedAccountSchema() map[string]*schema.Schema {
 
 	return schema
 }
+
+// getIdentity get the identity of resources identified by their Password Safe ID, so they can be imported
+// and listed by ID.
+func getIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"id": {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "Password Safe ID of the resource.",
+				},
+			}
+		},
+	}
+}
+
+// setIdentity sets the identity of the resource to its ID.
+func setIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set("id", d.Id())
+}
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"strconv"
 
+	passwordsafeEntities "terraform-provider-passwordsafe/providers/entities"
+	"terraform-provider-passwordsafe/providers/utils"
+
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	managed_accounts "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_account"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,16 +21,38 @@ import (
 // getManagedAccount DataSource.
 func getManagedAccount() *schema.Resource {
 	return &schema.Resource{
-		Description: "Managed Account Datasource, gets managed account.",
+		Description: "Managed Account Datasource, gets managed account by system name and account name, managed account ID, or managed system ID and account name.",
 		ReadContext: getManagedAccountReadContext,
 		Schema: map[string]*schema.Schema{
 			"system_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"system_name", "managed_account_id", "managed_system_id"},
+				RequiredWith: []string{"account_name"},
 			},
 			"account_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"managed_account_id"},
+			},
+			"managed_account_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Managed account ID",
+				ExactlyOneOf: []string{"system_name", "managed_account_id", "managed_system_id"},
+			},
+			"managed_system_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Managed system ID, used together with account_name",
+				ExactlyOneOf: []string{"system_name", "managed_account_id", "managed_system_id"},
+				RequiredWith: []string{"account_name"},
+			},
+			"separator": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/",
+				Description: "Separator used to join system_name and account_name",
 			},
 			"value": &schema.Schema{
 				Type:      schema.TypeString,
@@ -46,11 +71,27 @@ func resourceManagedAccount() *schema.Resource {
 		Read:        resourceManagedAccountRead,
 		Update:      resourceManagedAccountUpdate,
 		Delete:      resourceManagedAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: getIdentity(),
+
+		CustomizeDiff: customizeManagedAccountDiff,
 
 		Schema: getManagedAccountSchema(),
 	}
 }
 
+// customizeManagedAccountDiff checks change_frequency_days is set when change_frequency_type is xdays, so an
+// invalid change schedule fails at plan time.
+func customizeManagedAccountDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("change_frequency_type") || !d.NewValueKnown("change_frequency_days") {
+		return nil
+	}
+
+	return utils.ValidateChangeFrequencyDays(d.Get("change_frequency_type").(string), d.Get("change_frequency_days").(int))
+}
+
 // Create context for resourceManagedAccount Resource.
 func resourceManagedAccountCreate(d *schema.ResourceData, m interface{}) error {
 
@@ -103,12 +144,13 @@ func resourceManagedAccountCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(fmt.Sprintf("%d", createResponse.ManagedAccountID))
-	return nil
+	return setIdentity(d)
 }
 
 // Read context for resourceManagedAccount Resource.
 func resourceManagedAccountRead(d *schema.ResourceData, m interface{}) error {
-	return nil
+	// only the identity is set, states created by previous versions have none
+	return setIdentity(d)
 }
 
 // Update context for resourceManagedAccount Resource.
@@ -151,11 +193,12 @@ func getManagedAccountReadContext(ctx context.Context, d *schema.ResourceData, m
 
 	meta := m.(*providerMeta)
 
-	system_name := d.Get("system_name").(string)
-	account_name := d.Get("account_name").(string)
-
-	manageAccountObj, _ := managed_accounts.NewManagedAccountObj(*meta.authObj, zapLogger)
-	gotManagedAccount, err := manageAccountObj.GetSecret(system_name+"/"+account_name, "/")
+	cacheKey := utils.ManagedAccountCacheKey(d.Get("system_name").(string), d.Get("account_name").(string), d.Get("managed_account_id").(int), d.Get("managed_system_id").(int), "")
+	gotManagedAccount, err := utils.GetCachedCredential(meta.authObj, cacheKey, func() (string, error) {
+		return utils.RetryAfterSessionRenewal(meta.authObj, func() (string, error) {
+			return getManagedAccountValue(meta, d)
+		})
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -168,6 +211,31 @@ func getManagedAccountReadContext(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// getManagedAccountValue gets the managed account credential by managed account ID,
+// by managed system ID and account name or by system name and account name.
+func getManagedAccountValue(meta *providerMeta, d *schema.ResourceData) (string, error) {
+	var managedAccount passwordsafeEntities.ManagedAccountDetails
+	var err error
+
+	accountName := d.Get("account_name").(string)
+
+	if managedAccountID, ok := d.GetOk("managed_account_id"); ok {
+		managedAccount, err = utils.GetManagedAccountByID(*meta.authObj, managedAccountID.(int), zapLogger)
+	} else if managedSystemID, ok := d.GetOk("managed_system_id"); ok {
+		managedAccount, err = utils.GetManagedAccountBySystemID(*meta.authObj, managedSystemID.(int), accountName, zapLogger)
+	} else {
+		separator := d.Get("separator").(string)
+		manageAccountObj, _ := managed_accounts.NewManagedAccountObj(*meta.authObj, zapLogger)
+		return manageAccountObj.GetSecret(d.Get("system_name").(string)+separator+accountName, separator)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return utils.GetManagedAccountCredential(*meta.authObj, managedAccount, zapLogger)
+}
+
 // hash function.
 func hash(s string) string {
 	sha := sha256.Sum256([]byte(s))
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"go.uber.org/zap"
 )
 
@@ -48,6 +50,19 @@ func InitializeGlobalConfig() {
 	}
 }
 
+// testResourceDataWithIdentity creates a ResourceData from raw values, with the identity of the resources
+// identified by their Password Safe ID.
+func testResourceDataWithIdentity(t *testing.T, resourceSchema map[string]*schema.Schema, raw map[string]interface{}) *schema.ResourceData {
+	config := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	data := schema.TestResourceDataWithIdentityRaw(t, resourceSchema, getIdentity().SchemaFunc(), map[string]string{})
+	for key := range resourceSchema {
+		if err := data.Set(key, config.Get(key)); err != nil {
+			t.Fatalf("Error setting %v: %v", key, err)
+		}
+	}
+	return data
+}
+
 func TestResourceManagedAccountCreate(t *testing.T) {
 
 	InitializeGlobalConfig()
@@ -59,7 +74,7 @@ func TestResourceManagedAccountCreate(t *testing.T) {
 	}
 	var resourceSchema = getManagedAccountSchema()
 
-	data := schema.TestResourceDataRaw(t, resourceSchema, rawData)
+	data := testResourceDataWithIdentity(t, resourceSchema, rawData)
 
 	var authenticate, _ = authentication.Authenticate(*authParams)
 
@@ -116,6 +131,11 @@ func TestResourceManagedAccountCreate(t *testing.T) {
 		t.Errorf("Test case Failed: %v", err)
 	}
 
+	identity, _ := data.Identity()
+	if identity.Get("id") != "10" {
+		t.Errorf("Test case Failed: expected identity id 10, got %v", identity.Get("id"))
+	}
+
 }
 
 func TestResourceManagedAccountCreateError(t *testing.T) {
@@ -196,20 +216,7 @@ func TestGetManagedAccountReadContext(t *testing.T) {
 		"value":        "/",
 	}
 
-	var resourceSchema = map[string]*schema.Schema{
-		"system_name": &schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-		},
-		"account_name": &schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-		},
-		"value": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-		},
-	}
+	var resourceSchema = getManagedAccount().Schema
 
 	data := schema.TestResourceDataRaw(t, resourceSchema, rawData)
 
@@ -282,6 +289,69 @@ func TestGetManagedAccountReadContext(t *testing.T) {
 
 }
 
+func TestGetManagedAccountReadContextByID(t *testing.T) {
+
+	rawData := map[string]interface{}{
+		"managed_account_id": 10,
+	}
+
+	data := schema.TestResourceDataRaw(t, getManagedAccount().Schema, rawData)
+
+	var authenticate, _ = authentication.Authenticate(*authParams)
+
+	// mock config
+	testConfig := SecretTestConfigStringResponse{
+		name: "TestGetManagedAccountReadContextByID",
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+			switch r.URL.Path {
+
+			case "/ManagedAccounts/10":
+				_, err := w.Write([]byte(`{"ManagedAccountID":10,"ManagedSystemID":1,"AccountName":"account/name"}`))
+				if err != nil {
+					t.Error("Test case Failed")
+				}
+
+			case "/Requests":
+				_, err := w.Write([]byte(`124`))
+				if err != nil {
+					t.Error("Test case Failed")
+				}
+
+			case "/Credentials/124":
+				_, err := w.Write([]byte(`"fake_credential"`))
+				if err != nil {
+					t.Error("Test case Failed")
+				}
+
+			case "/Requests/124/checkin":
+				_, err := w.Write([]byte(``))
+				if err != nil {
+					t.Error("Test case Failed")
+				}
+
+			default:
+				http.NotFound(w, r)
+			}
+
+		})),
+	}
+
+	apiUrl, _ := url.Parse(testConfig.server.URL + "/")
+	authenticate.ApiUrl = *apiUrl
+
+	err := getManagedAccountReadContext(context.Background(), data, &providerMeta{authObj: authenticate})
+
+	if err != nil {
+		t.Errorf("Test case Failed: %v", err)
+	}
+
+	if data.Get("value").(string) != "fake_credential" {
+		t.Errorf("Test case Failed %v, %v", data.Get("value"), "fake_credential")
+	}
+
+}
+
 func TestResourceManagedAccountDelete(t *testing.T) {
 
 	InitializeGlobalConfig()
@@ -477,3 +547,40 @@ func TestGetManagedAccountSchemaSensitiveFields(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceManagedAccountChangeSchedule(t *testing.T) {
+	resource := resourceManagedAccount()
+
+	plan := func(schedule map[string]interface{}) error {
+		config := map[string]interface{}{
+			"system_name":  "system01",
+			"account_name": "account01",
+			"password":     "password",
+		}
+		for key, value := range schedule {
+			config[key] = value
+		}
+
+		resourceConfig := terraform.NewResourceConfigRaw(config)
+		if diags := resource.Validate(resourceConfig); diags.HasError() {
+			return fmt.Errorf("%v", diags[0].Summary)
+		}
+		_, err := resource.Diff(context.Background(), nil, resourceConfig, nil)
+		return err
+	}
+
+	if err := plan(map[string]interface{}{"change_frequency_type": "xdays", "change_frequency_days": 30, "change_time": "02:00"}); err != nil {
+		t.Errorf("unexpected error for a valid change schedule: %v", err)
+	}
+
+	for _, schedule := range []map[string]interface{}{
+		{"change_frequency_type": "weekly"},
+		{"change_frequency_type": "xdays"},
+		{"change_frequency_days": 1000},
+		{"change_time": "25:00"},
+	} {
+		if err := plan(schedule); err == nil {
+			t.Errorf("expected an error for the change schedule %v", schedule)
+		}
+	}
+}
@@ -3,37 +3,28 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
-	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
 	backoff "github.com/cenkalti/backoff/v4"
-	"go.uber.org/zap"
 
+	"terraform-provider-passwordsafe/providers/constants"
 	localutils "terraform-provider-passwordsafe/providers/utils"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// Define the zap configuration
-var config = zap.Config{
-	Level:            zap.NewAtomicLevelAt(zap.DebugLevel),
-	Encoding:         "console", // You can use "json" for structured logging
-	EncoderConfig:    zap.NewDevelopmentEncoderConfig(),
-	OutputPaths:      []string{"stderr", "providerSdkv2.log"}, // Logs to both stderr and the file
-	ErrorOutputPaths: []string{"stderr"},
-}
-
-// Build the logger with the configuration
-var logger, _ = config.Build()
-
-// create a zap logger wrapper
-var zapLogger = logging.NewZapLogger(logger)
+// zapLogger sends the provider and client library logs to tflog, it is shared by both providers.
+var zapLogger = localutils.NewProviderLogger()
 
 // Provider Definition.
 func Provider() *schema.Provider {
@@ -54,104 +45,253 @@ func Provider() *schema.Provider {
 			"api_key": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The API key for making requests to the Password Safe instance. For use when authenticating to Password Safe.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvAPIKey, nil),
+				Description: "The API key for making requests to the Password Safe instance. For use when authenticating to Password Safe. Can also be set with the PS_API_KEY environment variable.",
 			},
 			"client_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "API OAuth Client ID.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientID, nil),
+				Description: "API OAuth Client ID. Can also be set with the PS_CLIENT_ID environment variable.",
 			},
 			"client_secret": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "API OAuth Client Secret.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientSecret, nil),
+				Description: "API OAuth Client Secret. Can also be set with the PS_CLIENT_SECRET environment variable.",
 			},
 			"url": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The URL for the Password Safe instance from which to request a secret.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvURL, nil),
+				Description: "The URL for the Password Safe instance from which to request a secret. Can also be set with the PS_URL environment variable.",
 			},
 			"api_version": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
-				Description: "The recommended version is 3.1. If no version is specified, the default API version 3.0 will be used",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvAPIVersion, ""),
+				Description: "The recommended version is 3.1. If no version is specified, the default API version 3.0 will be used. Can also be set with the PS_API_VERSION environment variable.",
 			},
 			"api_account_name": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The user name for the API request to the Password Safe instance. For use when authenticating with an API key, it is used as the “runas user” in the authorization header of the request.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvAPIAccountName, nil),
+				Description: "The user name for the API request to the Password Safe instance. For use when authenticating with an API key, it is used as the “runas user” in the authorization header of the request. Can also be set with the PS_ACCOUNT_NAME environment variable.",
 			},
 			"verify_ca": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
-				Description: "Indicates whether to verify the certificate authority on the Password Safe instance. For use when authenticating to Password Safe.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvVerifyCA, true),
+				Description: "Indicates whether to verify the certificate authority on the Password Safe instance. For use when authenticating to Password Safe. Can also be set with the PS_VERIFY_CA environment variable.",
 			},
 			"client_certificates_folder_path": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
-				Description: "The path to the Client Certificate associated with the Password Safe instance for use when authenticating with an API key using a Client Certificate.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientCertificatesPath, ""),
+				Description: "The path to the Client Certificate associated with the Password Safe instance for use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_PATH environment variable.",
 			},
 			"client_certificate_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
-				Description: "The name of the Client Certificate for use when authenticating with an API key using a Client Certificate.",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientCertificateName, ""),
+				Description: "The name of the Client Certificate for use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_NAME environment variable.",
 			},
 			"client_certificate_password": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
-				Description: "The password associated with the Client Certificate. For use when authenticating with an API key using a Client Certificate",
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientCertificatePassword, ""),
+				Description: "The password associated with the Client Certificate. For use when authenticating with an API key using a Client Certificate. Can also be set with the PS_CERTIFICATE_PASSWORD environment variable.",
+			},
+			"client_certificate_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientCertificatePEM, ""),
+				Description: "PEM encoded client certificate for mutual TLS, as an alternative to a PFX file. Requires client_key_pem or client_key_file. Can also be set with the PS_CLIENT_CERTIFICATE_PEM environment variable.",
+			},
+			"client_key_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientKeyPEM, ""),
+				Description: "PEM encoded private key of the client certificate (PKCS#8, PKCS#1 or SEC 1). An encrypted PKCS#8 key is decrypted with client_certificate_password. Can also be set with the PS_CLIENT_KEY_PEM environment variable.",
+			},
+			"client_certificate_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientCertificateFile, ""),
+				Description: "Path to a PEM file with the client certificate for mutual TLS, as an alternative to client_certificate_pem. Can also be set with the PS_CLIENT_CERTIFICATE_FILE environment variable.",
+			},
+			"client_key_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvClientKeyFile, ""),
+				Description: "Path to a PEM file with the private key of the client certificate, as an alternative to client_key_pem. Can also be set with the PS_CLIENT_KEY_FILE environment variable.",
+			},
+			"ca_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvCACertFile, ""),
+				Description: "Path to a PEM file with CA certificates trusted in addition to the system roots to verify the Password Safe instance. Can also be set with the PS_CA_CERT_FILE environment variable.",
+			},
+			"ca_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvCACertPEM, ""),
+				Description: "PEM encoded CA certificates trusted in addition to the system roots to verify the Password Safe instance. Can also be set with the PS_CA_CERT_PEM environment variable.",
+			},
+			"tls_server_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvTLSServerName, ""),
+				Description: "Server name used to verify the certificate of the Password Safe instance when it differs from the host in url, for example behind a load balancer. Can also be set with the PS_TLS_SERVER_NAME environment variable.",
+			},
+			"proxy_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvProxyURL, ""),
+				Description: "URL of the proxy used to reach the Password Safe instance (http, https or socks5). When not set, the HTTPS_PROXY and HTTP_PROXY environment variables are used. Can also be set with the PS_PROXY_URL environment variable.",
+			},
+			"proxy_username": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvProxyUsername, ""),
+				Description: "User name to authenticate to the proxy. Can also be set with the PS_PROXY_USERNAME environment variable.",
+			},
+			"proxy_password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvProxyPassword, ""),
+				Description: "Password to authenticate to the proxy. Can also be set with the PS_PROXY_PASSWORD environment variable.",
+			},
+			"no_proxy": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvNoProxy, ""),
+				Description: "Comma-separated list of hosts, domains or CIDR ranges reached without the proxy. When not set, the NO_PROXY environment variable is used. Can also be set with the PS_NO_PROXY environment variable.",
+			},
+			"credential_cache": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvCredentialCache, false),
+				Description: "Whether to cache retrieved credentials in memory for the rest of the run, so several blocks referencing the same account or secret raise a single request. Credentials are never written to disk. Defaults to false. Can also be set with the PS_CREDENTIAL_CACHE environment variable.",
+			},
+			"credential_cache_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvCredentialCacheTTL, 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Time in seconds a cached credential stays valid when credential_cache is enabled. When not set or 0, credentials are cached until the provider exits. Can also be set with the PS_CREDENTIAL_CACHE_TTL environment variable.",
+			},
+			"request_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvRequestTimeout, localutils.DefaultRequestTimeoutSeconds),
+				ValidateFunc: validation.IntBetween(1, 300),
+				Description:  "Timeout in seconds of each HTTP request to the Password Safe API (default: 45). Can also be set with the PS_REQUEST_TIMEOUT environment variable.",
+			},
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvMaxRetries, localutils.DefaultMaxRetries),
+				ValidateFunc: validation.IntBetween(0, 10),
+				Description:  "Maximum number of retries of idempotent requests (GET, HEAD, OPTIONS, PUT, DELETE) that fail with a network error, a 429 or a 5xx response (default: 3). Can also be set with the PS_MAX_RETRIES environment variable.",
+			},
+			"retry_max_elapsed": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvRetryMaxElapsed, localutils.DefaultRetryMaxElapsedSeconds),
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  "Maximum time in seconds spent retrying a request, 0 means no limit (default: 30). Can also be set with the PS_RETRY_MAX_ELAPSED environment variable.",
+			},
+			"retry_initial_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvRetryInitialInterval, localutils.DefaultRetryInitialIntervalSeconds),
+				ValidateFunc: validation.IntBetween(1, 60),
+				Description:  "Time in seconds to wait before the first retry, the wait time grows exponentially between retries (default: 1). Can also be set with the PS_RETRY_INITIAL_INTERVAL environment variable.",
+			},
+			"credentials_command": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvCredentialsCommand, ""),
+				Description: "Command run to get the API credentials instead of setting them in the configuration. It must print a JSON object with api_key (and optionally api_account_name) or client_id and client_secret, and optionally expires_at in RFC 3339 format. The command runs again once the credentials expired. Can also be set with the PS_CREDENTIALS_COMMAND environment variable.",
+			},
+			"credentials_command_args": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arguments passed to credentials_command, the command is not run through a shell.",
+			},
+			"credentials_command_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvCredentialsCommandTimeout, localutils.DefaultCredentialsCommandTimeoutSeconds),
+				ValidateFunc: validation.IntBetween(1, 600),
+				Description:  "Timeout in seconds of credentials_command (default: 30). Can also be set with the PS_CREDENTIALS_COMMAND_TIMEOUT environment variable.",
+			},
+			"log_level": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(constants.EnvLogLevel, localutils.DefaultLogLevel),
+				ValidateFunc: validation.StringInSlice(localutils.LogLevels, false),
+				Description:  "Minimum level of the provider logs: debug, info, warn or error (default: debug). Logs are sent to Terraform, which shows them according to TF_LOG_PROVIDER. Can also be set with the PS_LOG_LEVEL environment variable.",
+			},
+			"log_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(constants.EnvLogFile, ""),
+				Description: "File the provider logs are appended to, in addition to the Terraform logs. Secrets are redacted. Can also be set with the PS_LOG_FILE environment variable.",
 			},
 		},
-		ConfigureContextFunc: providerConfigure,
+		ConfigureContextFunc: configureWithSharedSession(),
 	}
 }
 
-// ValidateCredentialsAndConfig make basic validations in credential and config data.
-func ValidateCredentialsAndConfig(apikey string, clientId string, clientSecret string, url string, accountName string) diag.Diagnostics {
-	var diags diag.Diagnostics
-	if apikey == "" && clientId == "" && clientSecret == "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Invalid Authentication method",
-			Detail:   "Please add a valid credential (API Key / Client Credentials)",
-		})
-		return diags
-	}
+// configureLogging applies log_level and log_file, the configured credentials are redacted from the logs.
+func configureLogging(d *schema.ResourceData) error {
+	return localutils.ConfigureLogging(d.Get("log_level").(string), d.Get("log_file").(string),
+		d.Get("api_key").(string),
+		d.Get("client_secret").(string),
+		d.Get("client_certificate_password").(string),
+		d.Get("client_key_pem").(string),
+		d.Get("proxy_password").(string),
+	)
+}
 
-	if url == "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Invalid URL",
-			Detail:   "Please add a proper URL",
-		})
-		return diags
+// validateProviderConfig runs the provider configuration checks shared with the framework provider.
+func validateProviderConfig(d *schema.ResourceData) diag.Diagnostics {
+	config := localutils.NewProviderConfig()
+	config.RequireCredentials = true
+	for _, attribute := range localutils.ProviderConfigAttributes {
+		value, _ := d.Get(attribute).(string)
+		config.Set(attribute, value)
 	}
 
-	if apikey != "" && accountName == "" {
-		diags = append(diags, diag.Diagnostic{
+	var diags diag.Diagnostics
+	for _, configError := range localutils.ValidateProviderConfig(config) {
+		diagnostic := diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Invalid Account Name",
-			Detail:   "Please add a proper Account Name",
-		})
-		return diags
+			Summary:  configError.Summary,
+			Detail:   configError.Detail,
+		}
+		if configError.Attribute != "" {
+			diagnostic.AttributePath = cty.GetAttrPath(configError.Attribute)
+		}
+		diags = append(diags, diagnostic)
 	}
-	return nil
+	return diags
 }
 
 // Provider Init Config.
-func buildAuthenticationObj(httpClient utils.HttpClientObj, backoffDefinition *backoff.ExponentialBackOff, apikey, url, apiVersion, accountName, clientId, clientSecret string, retryMaxElapsedTimeMinutes int) (*auth.AuthenticationObj, error) {
+func buildAuthenticationObj(httpClient utils.HttpClientObj, backoffDefinition *backoff.ExponentialBackOff, apikey, url, apiVersion, accountName, clientId, clientSecret string, retryMaxElapsedSeconds int) (*auth.AuthenticationObj, error) {
 	base := auth.AuthenticationParametersObj{
 		HTTPClient:                 httpClient,
 		BackoffDefinition:          backoffDefinition,
 		EndpointURL:                url,
 		APIVersion:                 apiVersion,
 		Logger:                     zapLogger,
-		RetryMaxElapsedTimeSeconds: retryMaxElapsedTimeMinutes,
+		RetryMaxElapsedTimeSeconds: retryMaxElapsedSeconds,
 	}
 	if apikey != "" {
 		base.ApiKey = fmt.Sprintf("%v;runas=%v;", apikey, accountName)
@@ -162,6 +302,80 @@ func buildAuthenticationObj(httpClient utils.HttpClientObj, backoffDefinition *b
 	return auth.Authenticate(base)
 }
 
+// configureWithSharedSession wraps providerConfigure so the provider releases the
+// shared session taken by its previous configuration when it is configured again.
+func configureWithSharedSession() schema.ConfigureContextFunc {
+	var sessionMu sync.Mutex
+	sessionKey := ""
+
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		meta, diags := providerConfigure(ctx, d)
+		if diags.HasError() {
+			return meta, diags
+		}
+
+		sessionMu.Lock()
+		defer sessionMu.Unlock()
+
+		if sessionKey != "" {
+			_ = localutils.ReleaseSharedAuth(sessionKey)
+		}
+		sessionKey = meta.(*providerMeta).sessionKey
+		return meta, diags
+	}
+}
+
+// newHttpClientSettings builds the transport settings from the provider attributes.
+func newHttpClientSettings(d *schema.ResourceData) (localutils.HttpClientSettings, error) {
+	settings := localutils.NewHttpClientSettings(
+		d.Get("request_timeout").(int),
+		d.Get("max_retries").(int),
+		d.Get("retry_max_elapsed").(int),
+		d.Get("retry_initial_interval").(int),
+	)
+	settings.TLSServerName = d.Get("tls_server_name").(string)
+	settings.ProxyURL = d.Get("proxy_url").(string)
+	settings.ProxyUsername = d.Get("proxy_username").(string)
+	settings.ProxyPassword = d.Get("proxy_password").(string)
+	settings.NoProxy = d.Get("no_proxy").(string)
+
+	caCertificates, err := localutils.LoadCACertificates(d.Get("ca_cert_pem").(string), d.Get("ca_cert_file").(string))
+	if err != nil {
+		return localutils.HttpClientSettings{}, err
+	}
+	settings.CACertificates = caCertificates
+
+	return settings, settings.Validate()
+}
+
+// newCredentialsCommand returns the credentials_command settings of the provider block.
+func newCredentialsCommand(d *schema.ResourceData) localutils.CredentialsCommand {
+	args := []string{}
+	for _, arg := range d.Get("credentials_command_args").([]interface{}) {
+		argValue, _ := arg.(string)
+		args = append(args, argValue)
+	}
+
+	return localutils.CredentialsCommand{
+		Command: strings.TrimSpace(d.Get("credentials_command").(string)),
+		Args:    args,
+		Timeout: time.Duration(d.Get("credentials_command_timeout").(int)) * time.Second,
+	}
+}
+
+// enableCommandCredentialsRenewal makes the session sign in again with the credentials of credentials_command
+// when it expired, so expired command credentials are replaced.
+func enableCommandCredentialsRenewal(authObj *auth.AuthenticationObj, command localutils.CredentialsCommand, url, apiVersion, accountName string, retryMaxElapsedSeconds int) {
+	if command.Command == "" {
+		return
+	}
+
+	localutils.EnableCommandCredentialsRenewal(authObj, command, func(httpClient utils.HttpClientObj, credentials localutils.APICredentials) (*auth.AuthenticationObj, error) {
+		renewedAccountName := cmp.Or(strings.TrimSpace(credentials.APIAccountName), accountName)
+		return buildAuthenticationObj(httpClient, localutils.NewLibraryBackOff(), strings.TrimSpace(credentials.APIKey), url, apiVersion, renewedAccountName, credentials.ClientID, credentials.ClientSecret, retryMaxElapsedSeconds)
+	})
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 
 	apikey := d.Get("api_key").(string)
@@ -171,37 +385,49 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	apiVersion := d.Get("api_version").(string)
 	accountName := d.Get("api_account_name").(string)
 	verifyca := d.Get("verify_ca").(bool)
-	clientCertificatePath := d.Get("client_certificates_folder_path").(string)
-	clientCertificateName := d.Get("client_certificate_name").(string)
-	clientCertificatePassword := d.Get("client_certificate_password").(string)
+	clientCertificate := localutils.ClientCertificateSettings{
+		FolderPath:      d.Get("client_certificates_folder_path").(string),
+		Name:            d.Get("client_certificate_name").(string),
+		Password:        d.Get("client_certificate_password").(string),
+		CertificatePEM:  d.Get("client_certificate_pem").(string),
+		KeyPEM:          d.Get("client_key_pem").(string),
+		CertificateFile: d.Get("client_certificate_file").(string),
+		KeyFile:         d.Get("client_key_file").(string),
+	}
 
 	apikey = strings.TrimSpace(apikey)
 	url = strings.TrimSpace(url)
 	accountName = strings.TrimSpace(accountName)
 
+	if err := configureLogging(d); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	// Make basic validations.
-	diags := ValidateCredentialsAndConfig(apikey, clientId, clientSecret, url, accountName)
-	if diags != nil {
+	diags := validateProviderConfig(d)
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	retryMaxElapsedTimeMinutes := 2
-	clientTimeOutInSeconds := 30
+	credentialsCommand := newCredentialsCommand(d)
+	credentials, err := localutils.ResolveCommandCredentials(credentialsCommand, localutils.APICredentials{APIKey: apikey, APIAccountName: accountName, ClientID: clientId, ClientSecret: clientSecret})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	localutils.AddLogSecrets(credentials.APIKey, credentials.ClientSecret)
+	apikey, accountName, clientId, clientSecret = credentials.APIKey, credentials.APIAccountName, credentials.ClientID, credentials.ClientSecret
 
-	backoffDefinition := backoff.NewExponentialBackOff()
-	backoffDefinition.InitialInterval = 1 * time.Second
-	backoffDefinition.MaxElapsedTime = time.Duration(retryMaxElapsedTimeMinutes) * time.Second
-	backoffDefinition.RandomizationFactor = 0.5
+	httpClientSettings, err := newHttpClientSettings(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 
-	certificate := ""
-	certificateKey := ""
-	var err error
+	clientTimeOutInSeconds := d.Get("request_timeout").(int)
+	retryMaxElapsedSeconds := d.Get("retry_max_elapsed").(int)
 
-	if clientCertificateName != "" {
-		certificate, certificateKey, err = utils.GetPFXContent(clientCertificatePath, clientCertificateName, clientCertificatePassword, zapLogger)
-		if err != nil {
-			return nil, diag.FromErr(err)
-		}
+	certificate, certificateKey, err := localutils.GetClientCertificate(clientCertificate, zapLogger)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	// Create an instance of ValidationParams
@@ -215,7 +441,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		Logger:                     zapLogger,
 		Certificate:                certificate,
 		CertificateKey:             certificateKey,
-		RetryMaxElapsedTimeMinutes: &retryMaxElapsedTimeMinutes,
+		RetryMaxElapsedTimeMinutes: localutils.LibraryRetryMaxElapsedMinutes(time.Duration(retryMaxElapsedSeconds) * time.Second),
 	}
 
 	// validate inputs
@@ -233,19 +459,25 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		clientSecret,
 		accountName,
 		fmt.Sprintf("%t", verifyca),
-		clientCertificateName,
+		localutils.CertificateFingerprint(certificate),
+		httpClientSettings.String(),
+		fmt.Sprintf("%t", d.Get("credential_cache").(bool)),
+		fmt.Sprintf("%d", d.Get("credential_cache_ttl").(int)),
 	}, "|")
 
 	authenticate, signAppin, err := localutils.InitSharedAuth(cacheKey, func() (*auth.AuthenticationObj, error) {
-		httpClientObj, err := utils.GetHttpClient(45, verifyca, certificate, certificateKey, zapLogger)
+		httpClientObj, err := localutils.NewHttpClient(verifyca, certificate, certificateKey, httpClientSettings, zapLogger)
 		if err != nil {
 			return nil, err
 		}
-		return buildAuthenticationObj(*httpClientObj, backoffDefinition, apikey, url, apiVersion, accountName, clientId, clientSecret, retryMaxElapsedTimeMinutes)
+		return buildAuthenticationObj(*httpClientObj, localutils.NewLibraryBackOff(), apikey, url, apiVersion, accountName, clientId, clientSecret, retryMaxElapsedSeconds)
 	})
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	return &providerMeta{authObj: authenticate, signAppin: signAppin}, diags
+
+	enableCommandCredentialsRenewal(authenticate, credentialsCommand, url, apiVersion, accountName, retryMaxElapsedSeconds)
+	localutils.ConfigureCredentialCache(authenticate, d.Get("credential_cache").(bool), time.Duration(d.Get("credential_cache_ttl").(int))*time.Second)
+	return &providerMeta{authObj: authenticate, signAppin: signAppin, sessionKey: cacheKey}, diags
 
 }
@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"terraform-provider-passwordsafe/providers/constants"
 	"terraform-provider-passwordsafe/providers/utils"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +27,66 @@ func TestProvider(t *testing.T) {
 	assert.NoError(t, err, "Provider validation should not return an error")
 }
 
+func TestProviderConfigEmptyValues(t *testing.T) {
+	t.Setenv(constants.EnvAPIAccountName, "test-account")
+	t.Setenv(constants.EnvMaxRetries, "5")
+
+	ctx := context.Background()
+	server := Provider().GRPCProvider()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	tests := []struct {
+		name            string
+		accountName     tftypes.Value
+		maxRetries      tftypes.Value
+		expectedAccount string
+		expectedRetries int64
+	}{
+		{"null values", tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.Number, nil), "test-account", 5},
+		{"empty values", tftypes.NewValue(tftypes.String, ""), tftypes.NewValue(tftypes.Number, 0), "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values["api_account_name"] = tt.accountName
+			values["max_retries"] = tt.maxRetries
+			config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, values))
+			if err != nil {
+				t.Fatalf("NewDynamicValue: %v", err)
+			}
+
+			resp, err := server.PrepareProviderConfig(ctx, &tfprotov5.PrepareProviderConfigRequest{Config: &config})
+			if err != nil {
+				t.Fatalf("PrepareProviderConfig: %v", err)
+			}
+			prepared, err := resp.PreparedConfig.Unmarshal(configType)
+			if err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			var preparedValues map[string]tftypes.Value
+			var accountName string
+			var maxRetries big.Float
+			_ = prepared.As(&preparedValues)
+			_ = preparedValues["api_account_name"].As(&accountName)
+			_ = preparedValues["max_retries"].As(&maxRetries)
+
+			if retries, _ := maxRetries.Int64(); accountName != tt.expectedAccount || retries != tt.expectedRetries {
+				t.Errorf("Expected %q and %v, got %q and %v", tt.expectedAccount, tt.expectedRetries, accountName, retries)
+			}
+		})
+	}
+}
+
 // newSignInMockServer returns a mock Password Safe server that handles the
 // OAuth token exchange and SignAppIn. Tests that drive providerConfigure end
 // to end need this because the new InitSharedAuth performs the handshake
@@ -83,6 +149,21 @@ func TestProviderConfigureWithCredentials(t *testing.T) {
 	assert.Empty(t, diags, "Diagnostics should be empty if no errors")
 }
 
+// hasAttributeError returns true when diags contains an error with the summary on the attribute, or on the
+// configuration when attribute is empty.
+func hasAttributeError(diags diag.Diagnostics, attribute string, summary string) bool {
+	for _, diagnostic := range diags {
+		expectedPath := cty.Path(nil)
+		if attribute != "" {
+			expectedPath = cty.GetAttrPath(attribute)
+		}
+		if diagnostic.Severity == diag.Error && diagnostic.Summary == summary && diagnostic.AttributePath.Equals(expectedPath) {
+			return true
+		}
+	}
+	return false
+}
+
 func TestProviderConfigureEmtyUrl(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
 		"url":                             "",
@@ -102,9 +183,8 @@ func TestProviderConfigureEmtyUrl(t *testing.T) {
 		t.Errorf("Error %v", diags)
 	}
 
-	if diags[0].Detail != "Please add a proper URL" {
-		t.Errorf("Test case Failed %v, %v", diags[0].Detail, "Please add a proper URL")
-	}
+	assert.True(t, hasAttributeError(diags, "url", "Missing url"), "An empty url should be reported on the url attribute, got %v", diags)
+	assert.True(t, hasAttributeError(diags, "api_key", "Conflicting credentials"), "api_key and client credentials should conflict, got %v", diags)
 
 }
 
@@ -127,9 +207,7 @@ func TestProviderConfigureEmptyCredentials(t *testing.T) {
 		t.Errorf("Error %v", diags)
 	}
 
-	if diags[0].Detail != "Please add a valid credential (API Key / Client Credentials)" {
-		t.Errorf("Test case Failed %v, %v", diags[0].Detail, "Please add a valid credential (API Key / Client Credentials)")
-	}
+	assert.True(t, hasAttributeError(diags, "", "Missing credentials"), "Missing credentials should be reported, got %v", diags)
 
 }
 
@@ -152,8 +230,173 @@ func TestProviderConfigureEmptyAccountName(t *testing.T) {
 		t.Errorf("Error %v", diags)
 	}
 
-	if diags[0].Detail != "Please add a proper Account Name" {
-		t.Errorf("Test case Failed %v, %v", diags[0].Detail, "Please add a proper Account Name")
+	assert.True(t, hasAttributeError(diags, "api_account_name", "Missing api_account_name"), "A missing account name should be reported on api_account_name, got %v", diags)
+
+}
+
+func TestProviderConfigureFromEnvironment(t *testing.T) {
+	server := newSignInMockServer(t)
+	defer server.Close()
+	utils.ResetSharedAuthForTest()
+
+	t.Setenv(constants.EnvURL, server.URL+constants.APIPath)
+	t.Setenv(constants.EnvAPIAccountName, "test-account")
+	t.Setenv(constants.EnvClientID, "00000000-0000-0000-0000-000000000001")
+	t.Setenv(constants.EnvClientSecret, "00000000-0000-0000-0000-000000000002")
+	t.Setenv(constants.EnvVerifyCA, "false")
+	t.Setenv(constants.EnvCredentialCacheTTL, "60")
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+
+	assert.Equal(t, false, resourceData.Get("verify_ca"))
+	assert.Equal(t, 60, resourceData.Get("credential_cache_ttl"))
+
+	_, diags := providerConfigure(context.Background(), resourceData)
+
+	assert.Empty(t, diags, "Diagnostics should be empty if no errors")
+}
+
+func TestProviderConfigureConfigOverridesEnvironment(t *testing.T) {
+	t.Setenv(constants.EnvURL, "https://from-env.example.com")
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"url": "https://from-config.example.com",
+	})
+
+	assert.Equal(t, "https://from-config.example.com", resourceData.Get("url"))
+}
+
+func TestProviderHttpClientSettings(t *testing.T) {
+	t.Setenv(constants.EnvRequestTimeout, "")
+	t.Setenv(constants.EnvMaxRetries, "5")
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"retry_max_elapsed": 120,
+	})
+
+	assert.Equal(t, utils.DefaultRequestTimeoutSeconds, resourceData.Get("request_timeout"))
+	assert.Equal(t, 5, resourceData.Get("max_retries"))
+	assert.Equal(t, 120, resourceData.Get("retry_max_elapsed"))
+	assert.Equal(t, utils.DefaultRetryInitialIntervalSeconds, resourceData.Get("retry_initial_interval"))
+}
+
+func TestProviderConfigureIncompleteClientCertificate(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key":                "fake_api_key",
+		"url":                    "https://example.com/BeyondTrust/api/public/v3",
+		"api_account_name":       "test-account",
+		"client_certificate_pem": "-----BEGIN CERTIFICATE-----",
+	})
+
+	_, diags := providerConfigure(context.Background(), resourceData)
+
+	assert.True(t, diags.HasError(), "A client certificate without key should be rejected")
+}
+
+func TestProviderReconfigureReleasesSession(t *testing.T) {
+	utils.ResetSharedAuthForTest()
+
+	var signoutCalls int32
+	oldServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case constants.APIPath + "/Auth/connect/token":
+			_, _ = w.Write([]byte(`{"access_token": "fake_token", "expires_in": 600, "token_type": "Bearer", "scope": "publicapi"}`))
+		case constants.APIPath + "/Auth/SignAppIn":
+			_, _ = w.Write([]byte(`{"UserId":1, "UserName":"test", "EmailAddress":"test@beyondtrust.com"}`))
+		case constants.APIPath + "/Auth/Signout":
+			atomic.AddInt32(&signoutCalls, 1)
+		}
+	}))
+	defer oldServer.Close()
+	newServer := newSignInMockServer(t)
+	defer newServer.Close()
+
+	configure := configureWithSharedSession()
+	for _, serverURL := range []string{oldServer.URL, newServer.URL} {
+		resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"url":              serverURL + constants.APIPath,
+			"api_account_name": "test-account",
+			"api_key":          "test-api-key",
+			"verify_ca":        false,
+		})
+
+		_, diags := configure(context.Background(), resourceData)
+		assert.Empty(t, diags, "Diagnostics should be empty if no errors")
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&signoutCalls), "The session of the previous configuration should be signed out")
+}
+
+func TestProviderConfigureWithCredentialsCommand(t *testing.T) {
+	utils.ResetSharedAuthForTest()
+
+	var authorization atomic.Value
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == constants.APIPath+"/Auth/SignAppIn" {
+			authorization.Store(r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"UserId":1, "UserName":"test", "EmailAddress":"test@beyondtrust.com"}`))
+		}
+	}))
+	defer server.Close()
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"url":                      server.URL + constants.APIPath,
+		"api_account_name":         "test-account",
+		"verify_ca":                false,
+		"credentials_command":      "sh",
+		"credentials_command_args": []interface{}{"-c", `echo '{"api_key": "command-api-key"}'`},
+	})
+
+	_, diags := providerConfigure(context.Background(), resourceData)
+
+	assert.Empty(t, diags, "Diagnostics should be empty if no errors")
+	assert.Contains(t, authorization.Load(), "command-api-key;runas=test-account;", "The command API key should be used to sign in")
+}
+
+func TestProviderConfigureCredentialsCommandWithApiKey(t *testing.T) {
+	utils.ResetSharedAuthForTest()
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_key":             "fake_api_key",
+		"url":                 "https://example.com/BeyondTrust/api/public/v3",
+		"api_account_name":    "test-account",
+		"credentials_command": "true",
+	})
+
+	_, diags := providerConfigure(context.Background(), resourceData)
+
+	assert.True(t, diags.HasError(), "credentials_command and api_key should be rejected together")
+}
+
+func TestProviderConfigureInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    map[string]interface{}
+		attribute string
+		summary   string
+	}{
+		{"client id without secret", map[string]interface{}{"client_id": "test-client-id"}, "client_secret", "Missing client_secret"},
+		{"client secret without id", map[string]interface{}{"client_secret": "test-client-secret"}, "client_id", "Missing client_id"},
+		{"http url", map[string]interface{}{"api_key": "test-api-key", "url": "http://example.com/BeyondTrust/api/public/v3"}, "url", "Invalid url"},
+		{"url without api path", map[string]interface{}{"api_key": "test-api-key", "url": "https://example.com"}, "url", "Invalid url"},
+		{"api version", map[string]interface{}{"api_key": "test-api-key", "api_version": "3"}, "api_version", "Invalid api_version"},
+		{"certificate without folder", map[string]interface{}{"api_key": "test-api-key", "client_certificate_name": "cert.pfx"}, "client_certificates_folder_path", "Missing client_certificates_folder_path"},
+		{"command with api key", map[string]interface{}{"api_key": "test-api-key", "credentials_command": "true"}, "credentials_command", "Conflicting credentials"},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"url":              "https://example.com/BeyondTrust/api/public/v3",
+				"api_account_name": "test-account",
+			}
+			for attribute, value := range tt.config {
+				config[attribute] = value
+			}
+
+			_, diags := providerConfigure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, config))
+
+			assert.True(t, hasAttributeError(diags, tt.attribute, tt.summary), "Expected %q on %v, got %v", tt.summary, tt.attribute, diags)
+		})
+	}
 }
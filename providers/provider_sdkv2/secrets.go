@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"maps"
 
+	"terraform-provider-passwordsafe/providers/utils"
+
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
 	"github.com/BeyondTrust/go-client-library-passwordsafe/api/secrets"
 	"github.com/google/uuid"
@@ -73,6 +75,10 @@ func resourceCredentialSecret() *schema.Resource {
 		Read:        resourceSecretRead,
 		Update:      resourceSecretUpdate,
 		Delete:      resourceSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: getIdentity(),
 
 		Schema: credentialSecretAttributes,
 	}
@@ -99,7 +105,11 @@ func resourceTextSecret() *schema.Resource {
 		Read:        resourceSecretRead,
 		Update:      resourceSecretUpdate,
 		Delete:      resourceSecretDelete,
-		Schema:      textSecretAttributes,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: getIdentity(),
+		Schema:   textSecretAttributes,
 	}
 
 }
@@ -128,7 +138,11 @@ func resourceFileSecret() *schema.Resource {
 		Read:        resourceSecretRead,
 		Update:      resourceSecretUpdate,
 		Delete:      resourceSecretDelete,
-		Schema:      fileSecretAttributes,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
+		},
+		Identity: getIdentity(),
+		Schema:   fileSecretAttributes,
 	}
 
 }
@@ -170,7 +184,7 @@ func resourceCredentialSecretCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	d.SetId(createdSecret.Id)
-	return nil
+	return setIdentity(d)
 }
 
 // Create context for resourceTextSecret Resource.
@@ -208,7 +222,7 @@ func resourceTextSecretCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(createdSecret.Id)
-	return nil
+	return setIdentity(d)
 }
 
 // Create context for resourceFileSecret Resource.
@@ -248,12 +262,13 @@ func resourceFileSecretCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(createdSecret.Id)
-	return nil
+	return setIdentity(d)
 }
 
 // Read context for resourceSecret Resource.
 func resourceSecretRead(d *schema.ResourceData, m interface{}) error {
-	return nil
+	// only the identity is set, states created by previous versions have none
+	return setIdentity(d)
 }
 
 // Update context for resourceSecret Resource.
@@ -303,7 +318,9 @@ func getSecretByPathReadContext(ctx context.Context, d *schema.ResourceData, m i
 	decrypt := d.Get("decrypt").(bool)
 
 	secretObj, _ := secrets.NewSecretObj(*meta.authObj, zapLogger, 5000000, decrypt)
-	secret, err := secretObj.GetSecret(secretPath+separator+secretTitle, separator)
+	secret, err := utils.GetCachedCredential(meta.authObj, utils.SecretCacheKey(secretPath, secretTitle, separator, decrypt), func() (string, error) {
+		return secretObj.GetSecret(secretPath+separator+secretTitle, separator)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -93,7 +93,7 @@ func TestResourceFileSecretCreate(t *testing.T) {
 		},
 		"urls": getUrlsSchema(),
 	}
-	data := schema.TestResourceDataRaw(t, resourceSchema, rawData)
+	data := testResourceDataWithIdentity(t, resourceSchema, rawData)
 
 	var authenticate, _ = authentication.Authenticate(*authParams)
 
@@ -336,7 +336,7 @@ func TestResourceCredentialSecretCreate(t *testing.T) {
 		},
 		"urls": getUrlsSchema(),
 	}
-	data := schema.TestResourceDataRaw(t, resourceSchema, rawData)
+	data := testResourceDataWithIdentity(t, resourceSchema, rawData)
 
 	var authenticate, _ = authentication.Authenticate(*authParams)
 
@@ -779,7 +779,7 @@ func TestResourceTextSecretCreate(t *testing.T) {
 		},
 		"urls": getUrlsSchema(),
 	}
-	data := schema.TestResourceDataRaw(t, resourceSchema, rawData)
+	data := testResourceDataWithIdentity(t, resourceSchema, rawData)
 
 	var authenticate, _ = authentication.Authenticate(*authParams)
 
@@ -0,0 +1,156 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// access request statuses returned by the Requests endpoint.
+const (
+	AccessRequestStatusPending  = "pending"
+	AccessRequestStatusApproved = "approved"
+	AccessRequestStatusActive   = "active"
+	AccessRequestStatusDenied   = "denied"
+	AccessRequestStatusExpired  = "expired"
+)
+
+// GetAccessRequest gets an access request raised by the API user by request ID. The Requests endpoint cannot be
+// filtered by request ID, so the requests of every status are listed to find the request whatever its status.
+func GetAccessRequest(authenticationObj auth.AuthenticationObj, requestID string, zapLogger logging.Logger) (entities.AccessRequest, error) {
+	accessRequest, found, err := findAccessRequest(authenticationObj, requestID, "all", zapLogger)
+	if err != nil {
+		return entities.AccessRequest{}, err
+	}
+
+	if !found {
+		return entities.AccessRequest{}, fmt.Errorf("access request %v was not found", requestID)
+	}
+
+	return accessRequest, nil
+}
+
+// findAccessRequest finds an access request raised by the API user by request ID among the requests with status.
+func findAccessRequest(authenticationObj auth.AuthenticationObj, requestID string, status string, zapLogger logging.Logger) (entities.AccessRequest, bool, error) {
+	v := url.Values{}
+	v.Add("status", status)
+	v.Add("queue", "req")
+
+	requestsUrl := authenticationObj.ApiUrl.JoinPath("Requests").String() + "?" + v.Encode()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", requestsUrl, "", "GetAccessRequest", zapLogger)
+	if err != nil {
+		return entities.AccessRequest{}, false, err
+	}
+
+	var accessRequests []entities.AccessRequest
+	if err = json.Unmarshal(response, &accessRequests); err != nil {
+		return entities.AccessRequest{}, false, err
+	}
+
+	for _, accessRequest := range accessRequests {
+		if strconv.Itoa(accessRequest.RequestID) == requestID {
+			return accessRequest, true, nil
+		}
+	}
+
+	return entities.AccessRequest{}, false, nil
+}
+
+// IsAccessRequestApproved returns true when the access request can be used to retrieve credentials.
+func IsAccessRequestApproved(accessRequest entities.AccessRequest) bool {
+	status := strings.ToLower(accessRequest.Status)
+	return status == AccessRequestStatusActive || status == AccessRequestStatusApproved
+}
+
+// WaitForAccessRequestApproval polls an access request every pollInterval until it
+// is no longer pending, the timeout expires or the context is cancelled. Only the
+// pending requests are listed while waiting, the requests of every status are
+// listed once the request is no longer pending to get its final status. The
+// request is checked in when the timeout expires, the context is cancelled or the
+// request cannot be polled, so it is not left pending on the server.
+func WaitForAccessRequestApproval(ctx context.Context, authenticationObj auth.AuthenticationObj, requestID string, timeout time.Duration, pollInterval time.Duration, zapLogger logging.Logger) (entities.AccessRequest, error) {
+	deadline := time.Now().Add(timeout)
+
+	zapLogger.Warn(fmt.Sprintf("waiting up to %v for approval of access request %v", timeout, requestID))
+
+	for {
+		accessRequest, found, err := findAccessRequest(authenticationObj, requestID, AccessRequestStatusPending, zapLogger)
+		if err != nil {
+			return accessRequest, cancelAccessRequest(authenticationObj, requestID, err, zapLogger)
+		}
+
+		if !found {
+			return GetAccessRequest(authenticationObj, requestID, zapLogger)
+		}
+
+		if !strings.EqualFold(accessRequest.Status, AccessRequestStatusPending) {
+			return accessRequest, nil
+		}
+
+		zapLogger.Info(fmt.Sprintf("access request %v is pending approval", requestID))
+
+		if time.Now().Add(pollInterval).After(deadline) {
+			err = fmt.Errorf("timed out after %v waiting for approval of access request %v", timeout, requestID)
+			return accessRequest, cancelAccessRequest(authenticationObj, requestID, err, zapLogger)
+		}
+
+		select {
+		case <-ctx.Done():
+			return accessRequest, cancelAccessRequest(authenticationObj, requestID, ctx.Err(), zapLogger)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// cancelAccessRequest checks in a pending access request that is no longer waited for and returns cause, joined
+// with the check in error when the request could not be checked in.
+func cancelAccessRequest(authenticationObj auth.AuthenticationObj, requestID string, cause error, zapLogger logging.Logger) error {
+	if err := CheckinAccessRequest(authenticationObj, requestID, "Approval no longer awaited by Terraform", zapLogger); err != nil {
+		return errors.Join(cause, fmt.Errorf("error checking in access request %v: %w", requestID, err))
+	}
+	return cause
+}
+
+// CheckinAccessRequest checks in an access request, a pending request is cancelled. reason is optional.
+func CheckinAccessRequest(authenticationObj auth.AuthenticationObj, requestID string, reason string, zapLogger logging.Logger) error {
+	body, err := json.Marshal(map[string]string{"Reason": reason})
+	if err != nil {
+		return err
+	}
+
+	checkinUrl := authenticationObj.ApiUrl.JoinPath("Requests", requestID, "Checkin").String()
+	_, err = callPasswordSafeAPI(authenticationObj, "PUT", checkinUrl, string(body), "CheckinAccessRequest", zapLogger)
+	return err
+}
+
+// request termination scopes, the API collection whose active requests are terminated.
+const (
+	TerminateRequestsManagedAccount = "ManagedAccounts"
+	TerminateRequestsManagedSystem  = "ManagedSystems"
+	TerminateRequestsUser           = "Users"
+)
+
+// TerminateRequests terminates all the active access requests of a managed account, managed system or user, scope
+// is one of the TerminateRequests constants. reason is optional.
+func TerminateRequests(authenticationObj auth.AuthenticationObj, scope string, id int, reason string, zapLogger logging.Logger) error {
+	body, err := json.Marshal(map[string]string{"Reason": reason})
+	if err != nil {
+		return err
+	}
+
+	terminateUrl := authenticationObj.ApiUrl.JoinPath(scope, strconv.Itoa(id), "Requests", "Terminate").String()
+	_, err = callPasswordSafeAPI(authenticationObj, "POST", terminateUrl, string(body), "TerminateRequests", zapLogger)
+	return err
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// GetAliasByName gets an alias by name, the alias points to the currently active managed account.
+func GetAliasByName(authenticationObj auth.AuthenticationObj, aliasName string, zapLogger logging.Logger) (entities.Alias, error) {
+	v := url.Values{}
+	v.Add("name", aliasName)
+
+	aliasesUrl := authenticationObj.ApiUrl.JoinPath("Aliases").String() + "?" + v.Encode()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", aliasesUrl, "", "GetAliasByName", zapLogger)
+	if err != nil {
+		return entities.Alias{}, err
+	}
+
+	var alias entities.Alias
+	if err = json.Unmarshal(response, &alias); err != nil {
+		return entities.Alias{}, err
+	}
+
+	if alias.AliasId == 0 {
+		return entities.Alias{}, fmt.Errorf("alias %v was not found", aliasName)
+	}
+
+	return alias, nil
+}
+
+// CreateAliasAccessRequest creates an access request for the managed account an alias currently points to and returns the request ID.
+func CreateAliasAccessRequest(authenticationObj auth.AuthenticationObj, aliasID int, zapLogger logging.Logger) (string, error) {
+	data, err := json.Marshal(entities.AliasAccessRequest{
+		AccessType:      "View",
+		DurationMinutes: 5,
+		Reason:          "Terraform",
+		ConflictOption:  "reuse",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	requestsUrl := authenticationObj.ApiUrl.JoinPath("Aliases", strconv.Itoa(aliasID), "Requests").String()
+	response, err := callPasswordSafeAPI(authenticationObj, "POST", requestsUrl, string(data), "CreateAliasAccessRequest", zapLogger)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(strings.TrimSpace(string(response)), `"`), nil
+}
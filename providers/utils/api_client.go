@@ -0,0 +1,31 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"bytes"
+	"fmt"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	libentities "github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// callPasswordSafeAPI calls a Password Safe endpoint that is not covered by the
+// client library, reusing the authenticated session held by authenticationObj.
+func callPasswordSafeAPI(authenticationObj auth.AuthenticationObj, httpMethod string, url string, body string, method string, zapLogger logging.Logger) ([]byte, error) {
+	zapLogger.Debug(fmt.Sprintf("%v %v", httpMethod, url))
+
+	callSecretSafeAPIObj := &libentities.CallSecretSafeAPIObj{
+		Url:         url,
+		HttpMethod:  httpMethod,
+		Body:        *bytes.NewBufferString(body),
+		Method:      method,
+		AccessToken: "",
+		ApiKey:      "",
+		ContentType: "application/json",
+		ApiVersion:  "",
+	}
+
+	return authenticationObj.HttpClient.MakeRequest(callSecretSafeAPIObj, authenticationObj.ExponentialBackOff)
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Change frequency types of managed systems and managed accounts.
+const (
+	ChangeFrequencyFirst = "first"
+	ChangeFrequencyLast  = "last"
+	ChangeFrequencyXDays = "xdays"
+)
+
+// DefaultChangeFrequencyType and DefaultChangeTime are the schedule Password Safe uses when they are not set.
+const (
+	DefaultChangeFrequencyType = ChangeFrequencyFirst
+	DefaultChangeTime          = "23:30"
+)
+
+// ChangeFrequencyTypes are the values accepted in change_frequency_type: the first or last day of the month,
+// or every change_frequency_days days.
+var ChangeFrequencyTypes = []string{ChangeFrequencyFirst, ChangeFrequencyLast, ChangeFrequencyXDays}
+
+// ChangeTimeRegexp matches change_time values, 24 hour HH:MM.
+var ChangeTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// ChangeTimeMessage is the error message of an invalid change_time.
+const ChangeTimeMessage = "must be a 24 hour time in HH:MM format, between 00:00 and 23:59"
+
+// ValidateChangeSchedule validates the change_frequency_type, change_frequency_days and change_time fields.
+func ValidateChangeSchedule(changeFrequencyType string, changeFrequencyDays int, changeTime string) error {
+	if !slices.Contains(ChangeFrequencyTypes, changeFrequencyType) {
+		return fmt.Errorf("change_frequency_type must be one of %v, got %q", strings.Join(ChangeFrequencyTypes, ", "), changeFrequencyType)
+	}
+
+	if err := ValidateChangeFrequencyDays(changeFrequencyType, changeFrequencyDays); err != nil {
+		return err
+	}
+
+	if !ChangeTimeRegexp.MatchString(changeTime) {
+		return fmt.Errorf("change_time %s, got %q", ChangeTimeMessage, changeTime)
+	}
+	return nil
+}
+
+// NextChangeDate returns the date and time of the first scheduled password change after lastChange. first and
+// last changes happen on the first or last day of the month, xdays changes changeFrequencyDays days after lastChange.
+// The result is in the time zone of lastChange.
+func NextChangeDate(changeFrequencyType string, changeFrequencyDays int, changeTime string, lastChange time.Time) (time.Time, error) {
+	if err := ValidateChangeSchedule(changeFrequencyType, changeFrequencyDays, changeTime); err != nil {
+		return time.Time{}, err
+	}
+
+	clock, _ := time.Parse("15:04", changeTime)
+	year, month, day := lastChange.Date()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, clock.Hour(), clock.Minute(), 0, 0, lastChange.Location())
+	}
+
+	switch changeFrequencyType {
+	case ChangeFrequencyXDays:
+		return at(year, month, day+changeFrequencyDays), nil
+	case ChangeFrequencyLast:
+		// day 0 of the next month is the last day of the month.
+		if next := at(year, month+1, 0); next.After(lastChange) {
+			return next, nil
+		}
+		return at(year, month+2, 0), nil
+	}
+
+	if next := at(year, month, 1); next.After(lastChange) {
+		return next, nil
+	}
+	return at(year, month+1, 1), nil
+}
+
+// ParseChangeDate parses a last change date, either an RFC 3339 timestamp or a YYYY-MM-DD date in UTC.
+func ParseChangeDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("last change must be an RFC 3339 timestamp or a YYYY-MM-DD date, got %q", value)
+}
+
+// changeFrequencyDaysValidator checks change_frequency_days is set between 1 and 999 when change_frequency_type is
+// xdays, the validator is set on change_frequency_type.
+type changeFrequencyDaysValidator struct{}
+
+// ChangeFrequencyDaysRequired returns the validator checking change_frequency_days when change_frequency_type is xdays.
+func ChangeFrequencyDaysRequired() validator.String {
+	return changeFrequencyDaysValidator{}
+}
+
+func (v changeFrequencyDaysValidator) Description(_ context.Context) string {
+	return "change_frequency_days must be between 1 and 999 when change_frequency_type is xdays"
+}
+
+func (v changeFrequencyDaysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v changeFrequencyDaysValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.ValueString() != ChangeFrequencyXDays {
+		return
+	}
+
+	var changeFrequencyDays types.Int32
+	daysPath := req.Path.ParentPath().AtName("change_frequency_days")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, daysPath, &changeFrequencyDays)...)
+	if resp.Diagnostics.HasError() || changeFrequencyDays.IsUnknown() {
+		return
+	}
+
+	if err := ValidateChangeFrequencyDays(ChangeFrequencyXDays, int(changeFrequencyDays.ValueInt32())); err != nil {
+		resp.Diagnostics.AddAttributeError(daysPath, "Invalid change_frequency_days", v.Description(ctx)+".")
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/pbkdf2"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"strings"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
+)
+
+// ClientCertificateSettings holds the client certificate options of the provider, either a PFX file
+// (folder path and name) or a PEM certificate and key given inline or as files.
+type ClientCertificateSettings struct {
+	FolderPath      string
+	Name            string
+	Password        string
+	CertificatePEM  string
+	KeyPEM          string
+	CertificateFile string
+	KeyFile         string
+}
+
+// GetClientCertificate returns the PEM encoded client certificate and PKCS#8 private key used for mTLS,
+// or empty strings when no client certificate is configured.
+func GetClientCertificate(settings ClientCertificateSettings, logger logging.Logger) (string, string, error) {
+	certificate, err := pemOrFile(settings.CertificatePEM, settings.CertificateFile, "client_certificate_pem", "client_certificate_file")
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := pemOrFile(settings.KeyPEM, settings.KeyFile, "client_key_pem", "client_key_file")
+	if err != nil {
+		return "", "", err
+	}
+
+	if settings.Name != "" {
+		if certificate != "" || key != "" {
+			return "", "", errors.New("client_certificate_name cannot be used together with a PEM client certificate or key")
+		}
+		certificate, key, err = utils.GetPFXContent(settings.FolderPath, settings.Name, settings.Password, logger)
+		return strings.TrimSpace(certificate), strings.TrimSpace(key), err
+	}
+
+	if certificate == "" && key == "" {
+		return "", "", nil
+	}
+
+	if certificate == "" || key == "" {
+		return "", "", errors.New("a PEM client certificate requires both the certificate and the private key")
+	}
+
+	return parseClientCertificate(certificate, key, settings.Password)
+}
+
+// CertificateFingerprint returns a SHA-256 fingerprint of the certificate content, used in the shared session
+// cache key so a changed certificate is never served by a session opened with the previous one.
+func CertificateFingerprint(certificate string) string {
+	if certificate == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(certificate))
+	return hex.EncodeToString(sum[:])
+}
+
+// pemOrFile returns the inline PEM content or the content of the PEM file, only one of them can be set.
+func pemOrFile(content string, fileName string, contentAttribute string, fileAttribute string) (string, error) {
+	if content != "" && fileName != "" {
+		return "", fmt.Errorf("%v and %v cannot be used together", contentAttribute, fileAttribute)
+	}
+
+	if fileName == "" {
+		return strings.TrimSpace(content), nil
+	}
+
+	fileContent, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", fmt.Errorf("error reading %v: %w", fileAttribute, err)
+	}
+	return strings.TrimSpace(string(fileContent)), nil
+}
+
+// parseClientCertificate decrypts the private key when needed and checks it matches the certificate.
+func parseClientCertificate(certificate string, key string, password string) (string, string, error) {
+	keyDER, err := privateKeyToPKCS8(key, password)
+	if err != nil {
+		return "", "", err
+	}
+
+	pkcs8Key := strings.TrimSpace(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})))
+
+	if _, err = tls.X509KeyPair([]byte(certificate), []byte(pkcs8Key)); err != nil {
+		return "", "", fmt.Errorf("invalid client certificate: %w", err)
+	}
+
+	return certificate, pkcs8Key, nil
+}
+
+// privateKeyToPKCS8 returns the DER PKCS#8 form of a PEM private key, which can be PKCS#8 (encrypted or not),
+// PKCS#1 or SEC 1.
+func privateKeyToPKCS8(key string, password string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("invalid client key, PEM data was not found")
+	}
+
+	switch block.Type {
+	case "ENCRYPTED PRIVATE KEY":
+		if password == "" {
+			return nil, errors.New("the client key is encrypted, client_certificate_password is required")
+		}
+		return decryptPKCS8(block.Bytes, password)
+	case "PRIVATE KEY":
+		return block.Bytes, nil
+	case "RSA PRIVATE KEY":
+		rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return x509.MarshalPKCS8PrivateKey(rsaKey)
+	case "EC PRIVATE KEY":
+		ecKey, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return x509.MarshalPKCS8PrivateKey(ecKey)
+	}
+
+	return nil, fmt.Errorf("unsupported client key type %q", block.Type)
+}
+
+var (
+	oidPBES2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1  = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACPrefix    = asn1.ObjectIdentifier{1, 2, 840, 113549, 2}
+	oidDESEDE3CBC    = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+	oidAESCBCPrefix  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1}
+	prfHashFunctions = map[int]func() hash.Hash{7: sha1.New, 9: sha256.New, 10: sha512.New384, 11: sha512.New}
+	aesCBCKeyLengths = map[int]int{2: 16, 22: 24, 42: 32}
+)
+
+type encryptedPrivateKeyInfo struct {
+	EncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedData       []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// decryptPKCS8 decrypts a PKCS#8 EncryptedPrivateKeyInfo using PBES2 with PBKDF2 and AES-CBC or 3DES-CBC,
+// the schemes written by OpenSSL.
+func decryptPKCS8(der []byte, password string) ([]byte, error) {
+	var keyInfo encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &keyInfo); err != nil {
+		return nil, fmt.Errorf("invalid encrypted client key: %w", err)
+	}
+
+	if !keyInfo.EncryptionAlgorithm.Algorithm.Equal(oidPBES2) {
+		return nil, errors.New("unsupported client key encryption, only PBES2 is supported")
+	}
+
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(keyInfo.EncryptionAlgorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("invalid encrypted client key: %w", err)
+	}
+
+	newBlock, iv, keyLength, err := newPBES2Cipher(params.EncryptionScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptionKey, err := derivePBKDF2Key(params.KeyDerivationFunc, password, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := newBlock(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := decryptCBC(block, iv, keyInfo.EncryptedData)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = x509.ParsePKCS8PrivateKey(plainText); err != nil {
+		return nil, errors.New("error decrypting client key, check client_certificate_password")
+	}
+	return plainText, nil
+}
+
+// newPBES2Cipher returns the block cipher constructor, IV and key length of the PBES2 encryption scheme.
+func newPBES2Cipher(scheme pkix.AlgorithmIdentifier) (func([]byte) (cipher.Block, error), []byte, int, error) {
+	var iv []byte
+	if _, err := asn1.Unmarshal(scheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid encrypted client key: %w", err)
+	}
+
+	if scheme.Algorithm.Equal(oidDESEDE3CBC) {
+		return des.NewTripleDESCipher, iv, 24, nil
+	}
+
+	oid := scheme.Algorithm
+	if len(oid) == len(oidAESCBCPrefix)+1 && oid[:len(oidAESCBCPrefix)].Equal(oidAESCBCPrefix) {
+		if keyLength, ok := aesCBCKeyLengths[oid[len(oid)-1]]; ok {
+			return aes.NewCipher, iv, keyLength, nil
+		}
+	}
+
+	return nil, nil, 0, fmt.Errorf("unsupported client key cipher %v", scheme.Algorithm)
+}
+
+// derivePBKDF2Key derives the encryption key from the password using the PBKDF2 parameters.
+func derivePBKDF2Key(keyDerivationFunc pkix.AlgorithmIdentifier, password string, keyLength int) ([]byte, error) {
+	if !keyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, errors.New("unsupported client key derivation function, only PBKDF2 is supported")
+	}
+
+	var params pbkdf2Params
+	if _, err := asn1.Unmarshal(keyDerivationFunc.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("invalid encrypted client key: %w", err)
+	}
+
+	prf := params.PRF.Algorithm
+	if len(prf) == 0 {
+		prf = oidHMACWithSHA1
+	}
+
+	newHash, ok := prfHashFunctions[prf[len(prf)-1]]
+	if !ok || len(prf) != len(oidHMACPrefix)+1 || !prf[:len(oidHMACPrefix)].Equal(oidHMACPrefix) {
+		return nil, fmt.Errorf("unsupported client key PBKDF2 function %v", prf)
+	}
+
+	return pbkdf2.Key(newHash, password, params.Salt, params.IterationCount, keyLength)
+}
+
+// decryptCBC decrypts the data and removes the PKCS#7 padding.
+func decryptCBC(block cipher.Block, iv []byte, data []byte) ([]byte, error) {
+	if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("invalid encrypted client key data")
+	}
+
+	plainText := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plainText, data)
+
+	padding := int(plainText[len(plainText)-1])
+	if padding == 0 || padding > block.BlockSize() {
+		return nil, errors.New("error decrypting client key, check client_certificate_password")
+	}
+	return plainText[:len(plainText)-padding], nil
+}
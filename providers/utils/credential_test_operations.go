@@ -0,0 +1,42 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// RunManagedAccountCredentialTest tests the stored password of a managed account on its target system, returns
+// true when the password works.
+func RunManagedAccountCredentialTest(authenticationObj auth.AuthenticationObj, managedAccountID int, zapLogger logging.Logger) (bool, error) {
+	testUrl := authenticationObj.ApiUrl.JoinPath("ManagedAccounts", strconv.Itoa(managedAccountID), "Credentials", "Test").String()
+	return runCredentialTest(authenticationObj, testUrl, "RunManagedAccountCredentialTest", zapLogger)
+}
+
+// RunFunctionalAccountCredentialTest tests the stored password of a functional account, returns true when the
+// password works.
+func RunFunctionalAccountCredentialTest(authenticationObj auth.AuthenticationObj, functionalAccountID int, zapLogger logging.Logger) (bool, error) {
+	testUrl := authenticationObj.ApiUrl.JoinPath("FunctionalAccounts", strconv.Itoa(functionalAccountID), "Credentials", "Test").String()
+	return runCredentialTest(authenticationObj, testUrl, "RunFunctionalAccountCredentialTest", zapLogger)
+}
+
+// runCredentialTest calls a Credentials/Test endpoint and returns its result.
+func runCredentialTest(authenticationObj auth.AuthenticationObj, testUrl string, method string, zapLogger logging.Logger) (bool, error) {
+	response, err := callPasswordSafeAPI(authenticationObj, "POST", testUrl, "", method, zapLogger)
+	if err != nil {
+		return false, err
+	}
+
+	var result entities.CredentialTestResult
+	if err = json.Unmarshal(response, &result); err != nil {
+		return false, err
+	}
+
+	return result.Success, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
+)
+
+// DefaultCredentialsCommandTimeoutSeconds is the credentials_command timeout used when the attribute is not set.
+const DefaultCredentialsCommandTimeoutSeconds = 30
+
+// credentialsExpiryMargin renews command credentials shortly before they expire, so a sign in never
+// uses credentials that expire while the request is in flight.
+const credentialsExpiryMargin = 30 * time.Second
+
+// CredentialsCommand is the external command returning the API credentials as JSON.
+type CredentialsCommand struct {
+	Command string
+	Args    []string
+	Timeout time.Duration
+}
+
+// APICredentials is the JSON output of the credentials command. ExpiresAt (RFC 3339) is optional,
+// credentials without expiry are kept for the rest of the run.
+type APICredentials struct {
+	APIKey         string    `json:"api_key"`
+	APIAccountName string    `json:"api_account_name"`
+	ClientID       string    `json:"client_id"`
+	ClientSecret   string    `json:"client_secret"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+var (
+	commandCredentialsMu sync.Mutex
+	commandCredentials   = map[string]APICredentials{}
+)
+
+// Expired returns true when the credentials expire within the renewal margin.
+func (credentials APICredentials) Expired(now time.Time) bool {
+	return !credentials.ExpiresAt.IsZero() && !now.Add(credentialsExpiryMargin).Before(credentials.ExpiresAt)
+}
+
+// Validate checks the command returned an API key or a client ID and secret.
+func (credentials APICredentials) Validate() error {
+	switch {
+	case credentials.APIKey != "" && (credentials.ClientID != "" || credentials.ClientSecret != ""):
+		return errors.New("credentials_command output must contain either api_key or client_id and client_secret, not both")
+	case credentials.APIKey != "":
+		return nil
+	case credentials.ClientID == "" || credentials.ClientSecret == "":
+		return errors.New("credentials_command output must contain api_key or client_id and client_secret")
+	}
+	return nil
+}
+
+// GetCommandCredentials returns the credentials of the command, running it when the credentials of a
+// previous run expired. Both muxed providers configure with the same command and share its output.
+func GetCommandCredentials(command CredentialsCommand) (APICredentials, error) {
+	commandCredentialsMu.Lock()
+	defer commandCredentialsMu.Unlock()
+
+	key := credentialCacheKey(append([]string{command.Command}, command.Args...)...)
+	if credentials, ok := commandCredentials[key]; ok && !credentials.Expired(time.Now()) {
+		return credentials, nil
+	}
+
+	credentials, err := command.Run(context.Background())
+	if err != nil {
+		return APICredentials{}, err
+	}
+
+	commandCredentials[key] = credentials
+	return credentials, nil
+}
+
+// ResolveCommandCredentials returns the output of the command when it is set, otherwise the configured
+// credentials. The configured api_account_name is kept when the command does not return one.
+func ResolveCommandCredentials(command CredentialsCommand, configured APICredentials) (APICredentials, error) {
+	if command.Command == "" {
+		return configured, nil
+	}
+
+	if configured.APIKey != "" || configured.ClientID != "" || configured.ClientSecret != "" {
+		return APICredentials{}, errors.New("credentials_command cannot be used together with api_key, client_id or client_secret")
+	}
+
+	credentials, err := GetCommandCredentials(command)
+	if err != nil {
+		return APICredentials{}, err
+	}
+
+	credentials.APIKey = strings.TrimSpace(credentials.APIKey)
+	credentials.APIAccountName = cmp.Or(strings.TrimSpace(credentials.APIAccountName), configured.APIAccountName)
+	if credentials.APIKey != "" && credentials.APIAccountName == "" {
+		return APICredentials{}, errors.New("credentials_command returned an api_key without api_account_name, set api_account_name in the provider configuration")
+	}
+	return credentials, nil
+}
+
+// ClearCommandCredentials drops the credentials returned by previous command runs.
+func ClearCommandCredentials() {
+	commandCredentialsMu.Lock()
+	defer commandCredentialsMu.Unlock()
+	commandCredentials = map[string]APICredentials{}
+}
+
+// Run executes the command and parses its output. The command is run without a shell, its standard
+// error is only included in the error message when it fails.
+func (command CredentialsCommand) Run(ctx context.Context) (APICredentials, error) {
+	if command.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, command.Timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command.Command, command.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// child processes keeping the output open must not block the provider once the command was killed.
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return APICredentials{}, fmt.Errorf("credentials_command timed out after %v", command.Timeout)
+		}
+		return APICredentials{}, fmt.Errorf("error running credentials_command: %w: %v", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var credentials APICredentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		// the output is not included, it may contain secrets.
+		return APICredentials{}, errors.New("error parsing credentials_command output, a JSON object is expected")
+	}
+
+	return credentials, credentials.Validate()
+}
+
+// EnableCommandCredentialsRenewal makes the session renewal of authObj sign in with the credentials of
+// the command, which is run again once its credentials expired. build returns an AuthenticationObj
+// using the given credentials and the client of the session.
+func EnableCommandCredentialsRenewal(authObj *auth.AuthenticationObj, command CredentialsCommand, build func(utils.HttpClientObj, APICredentials) (*auth.AuthenticationObj, error)) {
+	setSessionRenewal(authObj, func() error {
+		credentials, err := GetCommandCredentials(command)
+		if err != nil {
+			return err
+		}
+		AddLogSecrets(credentials.APIKey, credentials.ClientSecret)
+
+		renewedAuthObj, err := build(authObj.HttpClient, credentials)
+		if err != nil {
+			return err
+		}
+
+		// the new session cookie is stored in the shared cookie jar, so authObj uses it as well.
+		_, err = renewedAuthObj.GetPasswordSafeAuthentication()
+		return err
+	})
+}
@@ -0,0 +1,508 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/utils"
+	backoff "github.com/cenkalti/backoff/v4"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// default transport settings, used by both providers when the attributes are not set.
+const (
+	DefaultRequestTimeoutSeconds       = 45
+	DefaultMaxRetries                  = 3
+	DefaultRetryMaxElapsedSeconds      = 30
+	DefaultRetryInitialIntervalSeconds = 1
+)
+
+// HttpClientSettings holds the provider transport settings shared by both providers.
+type HttpClientSettings struct {
+	RequestTimeout       time.Duration
+	MaxRetries           int
+	RetryMaxElapsed      time.Duration
+	RetryInitialInterval time.Duration
+	// CACertificates is a PEM bundle trusted in addition to the system roots.
+	CACertificates string
+	// TLSServerName overrides the server name used to verify the Password Safe certificate.
+	TLSServerName string
+	// ProxyURL is the outbound proxy, when empty the HTTPS_PROXY and HTTP_PROXY environment variables are used.
+	ProxyURL      string
+	ProxyUsername string
+	ProxyPassword string
+	// NoProxy lists the hosts reached without proxy, when empty the NO_PROXY environment variable is used.
+	NoProxy string
+}
+
+// NewHttpClientSettings builds transport settings from provider attribute values expressed in seconds.
+func NewHttpClientSettings(requestTimeout int, maxRetries int, retryMaxElapsed int, retryInitialInterval int) HttpClientSettings {
+	return HttpClientSettings{
+		RequestTimeout:       time.Duration(requestTimeout) * time.Second,
+		MaxRetries:           maxRetries,
+		RetryMaxElapsed:      time.Duration(retryMaxElapsed) * time.Second,
+		RetryInitialInterval: time.Duration(retryInitialInterval) * time.Second,
+	}
+}
+
+// Validate checks the settings are within the supported ranges.
+func (settings HttpClientSettings) Validate() error {
+	switch {
+	case settings.RequestTimeout < time.Second || settings.RequestTimeout > 300*time.Second:
+		return fmt.Errorf("request_timeout must be between 1 and 300 seconds")
+	case settings.MaxRetries < 0 || settings.MaxRetries > 10:
+		return fmt.Errorf("max_retries must be between 0 and 10")
+	case settings.RetryMaxElapsed < 0 || settings.RetryMaxElapsed > 3600*time.Second:
+		return fmt.Errorf("retry_max_elapsed must be between 0 and 3600 seconds")
+	case settings.RetryInitialInterval < time.Second || settings.RetryInitialInterval > 60*time.Second:
+		return fmt.Errorf("retry_initial_interval must be between 1 and 60 seconds")
+	case settings.ProxyPassword != "" && settings.ProxyUsername == "":
+		return fmt.Errorf("proxy_password requires proxy_username")
+	}
+	return validateProxyURL(settings.ProxyURL)
+}
+
+// validateProxyURL checks the proxy URL has a supported scheme and a host.
+func validateProxyURL(proxyURL string) error {
+	if proxyURL == "" {
+		return nil
+	}
+
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil || parsedURL.Host == "" {
+		return fmt.Errorf("invalid proxy_url %q", proxyURL)
+	}
+
+	switch parsedURL.Scheme {
+	case "http", "https", "socks5":
+		return nil
+	}
+	return fmt.Errorf("invalid proxy_url %q, the scheme must be http, https or socks5", proxyURL)
+}
+
+// String returns the settings as part of the shared session cache key.
+func (settings HttpClientSettings) String() string {
+	return fmt.Sprintf("%v/%v/%v/%v/%v/%v/%v/%v/%v/%v",
+		settings.RequestTimeout, settings.MaxRetries, settings.RetryMaxElapsed, settings.RetryInitialInterval,
+		CertificateFingerprint(settings.CACertificates), settings.TLSServerName,
+		settings.ProxyURL, settings.ProxyUsername, CertificateFingerprint(settings.ProxyPassword), settings.NoProxy)
+}
+
+// LoadCACertificates returns the CA bundle built from the inline PEM content and the PEM file, both can be set.
+func LoadCACertificates(caCertPEM string, caCertFile string) (string, error) {
+	bundle := []string{}
+
+	if strings.TrimSpace(caCertPEM) != "" {
+		bundle = append(bundle, strings.TrimSpace(caCertPEM))
+	}
+
+	if caCertFile != "" {
+		fileContent, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return "", fmt.Errorf("error reading ca_cert_file: %w", err)
+		}
+		bundle = append(bundle, strings.TrimSpace(string(fileContent)))
+	}
+
+	caCertificates := strings.Join(bundle, "\n")
+	if caCertificates != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(caCertificates)) {
+		return "", errors.New("ca_cert_pem and ca_cert_file do not contain any valid PEM certificate")
+	}
+	return caCertificates, nil
+}
+
+// NewHttpClient builds the library HTTP client and replaces its transport with one that
+// applies the request timeout to every attempt and retries idempotent calls on 429/5xx
+// responses and network errors.
+func NewHttpClient(verifyCa bool, certificate string, certificateKey string, settings HttpClientSettings, zapLogger logging.Logger) (*utils.HttpClientObj, error) {
+	httpClientObj, err := utils.GetHttpClient(int(settings.RequestTimeout/time.Second), verifyCa, certificate, certificateKey, zapLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	if transport, ok := httpClientObj.HttpClient.Transport.(*http.Transport); ok {
+		if err = configureServerVerification(transport.TLSClientConfig, settings); err != nil {
+			return nil, err
+		}
+		transport.Proxy = proxyFunc(settings)
+	}
+
+	// the timeout is applied per attempt by the retry transport.
+	httpClientObj.HttpClient.Timeout = 0
+	httpClientObj.HttpClient.Transport = &retryTransport{
+		next:     httpClientObj.HttpClient.Transport,
+		settings: settings,
+		logger:   zapLogger,
+		jar:      httpClientObj.HttpClient.Jar,
+		session:  &sessionRenewer{},
+	}
+
+	return httpClientObj, nil
+}
+
+// configureServerVerification appends the custom CA bundle to the system roots and sets the server name override.
+func configureServerVerification(tlsConfig *tls.Config, settings HttpClientSettings) error {
+	tlsConfig.ServerName = settings.TLSServerName
+
+	if settings.CACertificates == "" {
+		return nil
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !rootCAs.AppendCertsFromPEM([]byte(settings.CACertificates)) {
+		return errors.New("invalid CA certificates")
+	}
+	tlsConfig.RootCAs = rootCAs
+	return nil
+}
+
+// proxyFunc returns the proxy selection of the transport. proxy_url takes precedence over the HTTPS_PROXY and
+// HTTP_PROXY environment variables and no_proxy over NO_PROXY.
+func proxyFunc(settings HttpClientSettings) func(*http.Request) (*url.URL, error) {
+	proxyConfig := httpproxy.FromEnvironment()
+	if settings.ProxyURL != "" {
+		proxyConfig.HTTPProxy = settings.ProxyURL
+		proxyConfig.HTTPSProxy = settings.ProxyURL
+	}
+	if settings.NoProxy != "" {
+		proxyConfig.NoProxy = settings.NoProxy
+	}
+
+	selectProxy := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := selectProxy(req.URL)
+		if err != nil || proxyURL == nil || settings.ProxyUsername == "" {
+			return proxyURL, err
+		}
+
+		// the selected URL is shared between requests, credentials are set on a copy.
+		proxyURLWithUser := *proxyURL
+		proxyURLWithUser.User = url.UserPassword(settings.ProxyUsername, settings.ProxyPassword)
+		return &proxyURLWithUser, nil
+	}
+}
+
+// NewLibraryBackOff returns the backoff given to the client library. Retries are done by
+// the provider transport, so the library must give up after the first failed attempt,
+// otherwise non idempotent calls would be replayed.
+func NewLibraryBackOff() *backoff.ExponentialBackOff {
+	backoffDefinition := backoff.NewExponentialBackOff()
+	backoffDefinition.MaxElapsedTime = time.Nanosecond
+	return backoffDefinition
+}
+
+// LibraryRetryMaxElapsedMinutes returns the RetryMaxElapsedTimeMinutes field of the library validation
+// parameters. The field is in minutes and ValidateInputs sets it to 2 when it is 0, so a new variable is
+// returned and retry_max_elapsed, in seconds, is never rewritten by the library.
+func LibraryRetryMaxElapsedMinutes(retryMaxElapsed time.Duration) *int {
+	minutes := int(math.Ceil(retryMaxElapsed.Minutes()))
+	return &minutes
+}
+
+// retryTransport retries idempotent requests on 429/5xx responses and network errors, and signs in
+// again when the session expired.
+type retryTransport struct {
+	next     http.RoundTripper
+	settings HttpClientSettings
+	logger   logging.Logger
+	jar      http.CookieJar
+	session  *sessionRenewer
+}
+
+// errSessionRenewalDisabled is returned by renewAfter before EnableSessionRenewal was called.
+var errSessionRenewalDisabled = errors.New("session renewal is not enabled")
+
+// sessionRenewer runs the sign in again when the session expired. Requests failing at the same time
+// share a single sign in: only the first one of a generation renews it, the others wait on the
+// mutex and replay with the new session.
+type sessionRenewer struct {
+	mu         sync.Mutex
+	generation atomic.Uint64
+	renew      func() error
+}
+
+// current returns the session generation, it does not lock so the sign in requests can read it
+// while a renewal is running.
+func (s *sessionRenewer) current() uint64 {
+	return s.generation.Load()
+}
+
+// renewAfter signs in again unless the session was already renewed since generation.
+func (s *sessionRenewer) renewAfter(generation uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.renew == nil {
+		return errSessionRenewalDisabled
+	}
+	if s.generation.Load() != generation {
+		return nil
+	}
+	if err := s.renew(); err != nil {
+		return err
+	}
+	s.generation.Add(1)
+	return nil
+}
+
+// EnableSessionRenewal makes the client of authObj run the token exchange and SignAppIn again
+// when an API call is rejected with 401, idempotent calls are then replayed once.
+func EnableSessionRenewal(authObj *auth.AuthenticationObj) {
+	setSessionRenewal(authObj, func() error {
+		_, err := authObj.GetPasswordSafeAuthentication()
+		return err
+	})
+}
+
+// RetryAfterSessionRenewal runs flow, and runs it once more when it failed and the session of authObj was
+// renewed meanwhile. The client does not replay the requests that are not idempotent, such as the access request
+// posted to retrieve a credential, so flows made of those requests are run again with the new session instead.
+func RetryAfterSessionRenewal[T any](authObj *auth.AuthenticationObj, flow func() (T, error)) (T, error) {
+	transport, ok := authObj.HttpClient.HttpClient.Transport.(*retryTransport)
+	if !ok {
+		return flow()
+	}
+
+	generation := transport.session.current()
+	value, err := flow()
+	if err == nil || transport.session.current() == generation {
+		return value, err
+	}
+
+	transport.logger.Debug(fmt.Sprintf("session renewed, running the request again after: %v", err))
+	return flow()
+}
+
+// setSessionRenewal sets the sign in run when the session of authObj expired.
+func setSessionRenewal(authObj *auth.AuthenticationObj, renew func() error) {
+	transport, ok := authObj.HttpClient.HttpClient.Transport.(*retryTransport)
+	if !ok {
+		return
+	}
+
+	transport.session.mu.Lock()
+	defer transport.session.mu.Unlock()
+	transport.session.renew = renew
+}
+
+// RoundTrip sends the request, retrying it when allowed and renewing the session when it expired.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	generation := t.session.current()
+
+	resp, err := t.roundTripWithRetries(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || isAuthenticationRequest(req) {
+		return resp, err
+	}
+
+	return t.replayAfterRenewal(req, resp, generation)
+}
+
+// replayAfterRenewal signs in again and replays an idempotent request once. Other requests get the
+// 401 response, the next call uses the new session and callers run them again with RetryAfterSessionRenewal.
+func (t *retryTransport) replayAfterRenewal(req *http.Request, resp *http.Response, generation uint64) (*http.Response, error) {
+	if err := t.session.renewAfter(generation); err != nil {
+		if !errors.Is(err, errSessionRenewalDisabled) {
+			t.logger.Error(fmt.Sprintf("error renewing the session: %v", err))
+		}
+		return resp, nil
+	}
+
+	if !isIdempotentRequest(req) {
+		return resp, nil
+	}
+
+	replay, err := t.replayRequest(req)
+	if err != nil {
+		return resp, nil
+	}
+
+	t.logger.Debug(fmt.Sprintf("session renewed, replaying %v %v", req.Method, req.URL.Path))
+	discardResponse(resp)
+	return t.roundTripWithRetries(replay)
+}
+
+// replayRequest returns a copy of the request with a fresh body and the cookies of the new session,
+// the client added the expired ones before calling the transport.
+func (t *retryTransport) replayRequest(req *http.Request) (*http.Request, error) {
+	replay, err := rewindRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if replay == req {
+		replay = req.Clone(req.Context())
+	}
+
+	replay.Header.Del("Cookie")
+	if t.jar != nil {
+		for _, cookie := range t.jar.Cookies(replay.URL) {
+			replay.AddCookie(cookie)
+		}
+	}
+	return replay, nil
+}
+
+// roundTripWithRetries sends the request, retrying it when allowed.
+func (t *retryTransport) roundTripWithRetries(req *http.Request) (*http.Response, error) {
+	retryBackOff := t.newBackOff(req.Context())
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripWithTimeout(req)
+		if !isIdempotentRequest(req) || !isRetryableResponse(resp, err) {
+			return resp, err
+		}
+
+		wait := retryBackOff.NextBackOff()
+		if wait == backoff.Stop {
+			return resp, err
+		}
+		wait = max(wait, retryAfter(resp))
+
+		t.logger.Debug(fmt.Sprintf("retrying %v %v in %v, attempt %v", req.Method, req.URL.Path, wait, attempt+1))
+		discardResponse(resp)
+
+		if err = sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTripWithTimeout sends a single attempt, cancelling it when the request timeout expires.
+func (t *retryTransport) roundTripWithTimeout(req *http.Request) (*http.Response, error) {
+	if t.settings.RequestTimeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.settings.RequestTimeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// the context must stay alive until the body was read.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) newBackOff(ctx context.Context) backoff.BackOff {
+	exponentialBackOff := backoff.NewExponentialBackOff()
+	exponentialBackOff.InitialInterval = t.settings.RetryInitialInterval
+	exponentialBackOff.MaxElapsedTime = t.settings.RetryMaxElapsed
+	exponentialBackOff.RandomizationFactor = 0.5
+	exponentialBackOff.Reset()
+
+	return backoff.WithContext(backoff.WithMaxRetries(exponentialBackOff, uint64(max(t.settings.MaxRetries, 0))), ctx)
+}
+
+// isIdempotentRequest returns true for requests that can be sent again safely.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	}
+	return false
+}
+
+// isAuthenticationRequest returns true for the sign in and sign out calls, a 401 on them is not an
+// expired session.
+func isAuthenticationRequest(req *http.Request) bool {
+	path := strings.ToLower(req.URL.Path)
+	for _, suffix := range []string{"/auth/connect/token", "/auth/signappin", "/auth/signout"} {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableResponse returns true for network errors, 429 and 5xx responses.
+func isRetryableResponse(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter returns the delay requested by the server in the Retry-After header (seconds form).
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// rewindRequest returns a copy of the request with a fresh body for the next attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	newReq.Body = body
+	return newReq, nil
+}
+
+// sleepContext waits for the given duration or until the context is done.
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func discardResponse(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// cancelOnCloseBody releases the attempt context once the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	libentities "github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// listPageSize is the number of items requested per page when following offset/limit pagination.
+const listPageSize = 1000
+
+// ManagedAccountsFilter holds the filters of the managed accounts list, empty filters are not applied.
+type ManagedAccountsFilter struct {
+	SystemName    string
+	AccountName   string
+	WorkgroupName string
+	WorkgroupID   int
+	Type          string
+	PlatformID    int
+}
+
+// ManagedSystemsFilter holds the filters of the managed systems list, empty filters are not applied.
+type ManagedSystemsFilter struct {
+	SystemName  string
+	Type        int
+	WorkgroupID int
+	PlatformID  int
+}
+
+// GetManagedAccountsList gets up to limit managed accounts matching filter, every managed account when limit is 0.
+// The platform is filtered by the provider, the API has no such filter. The API filters the workgroup by name, so
+// the workgroup ID is resolved to the workgroup name.
+func GetManagedAccountsList(authenticationObj auth.AuthenticationObj, filter ManagedAccountsFilter, limit int, zapLogger logging.Logger) ([]libentities.ManagedAccount, error) {
+	if filter.WorkgroupID != 0 {
+		workgroup, err := GetWorkgroupByID(authenticationObj, filter.WorkgroupID, zapLogger)
+		if err != nil {
+			return nil, err
+		}
+		filter.WorkgroupName = workgroup.Name
+	}
+
+	query := url.Values{}
+	addQueryParameter(query, "systemName", filter.SystemName)
+	addQueryParameter(query, "accountName", filter.AccountName)
+	addQueryParameter(query, "workgroupName", filter.WorkgroupName)
+	addQueryParameter(query, "type", filter.Type)
+
+	var keep func(libentities.ManagedAccount) bool
+	if filter.PlatformID != 0 {
+		keep = func(item libentities.ManagedAccount) bool {
+			return item.PlatformID == filter.PlatformID
+		}
+	}
+
+	return getPagedList(authenticationObj, "ManagedAccounts", query, limit, keep, "GetManagedAccountsList", zapLogger)
+}
+
+// GetWorkgroupByID gets a workgroup by workgroup ID.
+func GetWorkgroupByID(authenticationObj auth.AuthenticationObj, workgroupID int, zapLogger logging.Logger) (libentities.WorkGroupResponse, error) {
+	workgroupUrl := authenticationObj.ApiUrl.JoinPath("Workgroups", strconv.Itoa(workgroupID)).String()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", workgroupUrl, "", "GetWorkgroupByID", zapLogger)
+	if err != nil {
+		return libentities.WorkGroupResponse{}, err
+	}
+
+	var workgroup libentities.WorkGroupResponse
+	if err = json.Unmarshal(response, &workgroup); err != nil {
+		return libentities.WorkGroupResponse{}, err
+	}
+
+	if workgroup.Name == "" {
+		return libentities.WorkGroupResponse{}, fmt.Errorf("workgroup %v was not found", workgroupID)
+	}
+
+	return workgroup, nil
+}
+
+// GetManagedSystemsList gets up to limit managed systems matching filter, every managed system when limit is 0.
+// The workgroup and the platform are filtered by the provider, the API has no such filters.
+func GetManagedSystemsList(authenticationObj auth.AuthenticationObj, filter ManagedSystemsFilter, limit int, zapLogger logging.Logger) ([]libentities.ManagedSystemResponseCreate, error) {
+	query := url.Values{}
+	addQueryParameter(query, "name", filter.SystemName)
+	if filter.Type != 0 {
+		query.Add("type", strconv.Itoa(filter.Type))
+	}
+
+	var keep func(libentities.ManagedSystemResponseCreate) bool
+	if filter.WorkgroupID != 0 || filter.PlatformID != 0 {
+		keep = func(item libentities.ManagedSystemResponseCreate) bool {
+			return (filter.WorkgroupID == 0 || item.WorkgroupID == filter.WorkgroupID) &&
+				(filter.PlatformID == 0 || item.PlatformID == filter.PlatformID)
+		}
+	}
+
+	return getPagedList(authenticationObj, "ManagedSystems", query, limit, keep, "GetManagedSystemsList", zapLogger)
+}
+
+// GetAssetsList gets up to limit assets of the workgroup with workgroup ID or name, every asset when limit is 0.
+func GetAssetsList(authenticationObj auth.AuthenticationObj, workgroup string, limit int, zapLogger logging.Logger) ([]libentities.AssetResponse, error) {
+	return getPagedList[libentities.AssetResponse](authenticationObj, "workgroups/"+url.PathEscape(workgroup)+"/assets", url.Values{}, limit, nil, "GetAssetsList", zapLogger)
+}
+
+// addQueryParameter adds the query parameter when value is set.
+func addQueryParameter(query url.Values, key string, value string) {
+	if value != "" {
+		query.Add(key, value)
+	}
+}
+
+// getPagedList gets the items of the endpoint in path following offset/limit pagination, up to limit items kept by
+// keep when limit is positive. keep filters the items the API cannot filter, nil keeps every item.
+func getPagedList[T any](authenticationObj auth.AuthenticationObj, path string, query url.Values, limit int, keep func(T) bool, method string, zapLogger logging.Logger) ([]T, error) {
+	endpointUrl := authenticationObj.ApiUrl.JoinPath(path).String()
+
+	var items []T
+	var previousResponse []byte
+	offset := 0
+
+	for {
+		pageSize := listPageSize
+		if keep == nil && limit > 0 {
+			pageSize = min(pageSize, limit-len(items))
+		}
+
+		pageQuery := maps.Clone(query)
+		pageQuery.Set("limit", strconv.Itoa(pageSize))
+		pageQuery.Set("offset", strconv.Itoa(offset))
+
+		response, err := callPasswordSafeAPI(authenticationObj, "GET", endpointUrl+"?"+pageQuery.Encode(), "", method, zapLogger)
+		if err != nil {
+			return nil, err
+		}
+
+		page, totalCount, err := decodeListPage[T](response)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding %v page at offset %v: %w", path, offset, err)
+		}
+
+		// the endpoint ignores offset when it returns the previous page again, its items are already listed.
+		if totalCount == 0 && previousResponse != nil && bytes.Equal(response, previousResponse) {
+			return items, nil
+		}
+		previousResponse = response
+
+		for _, item := range page {
+			if keep == nil || keep(item) {
+				items = append(items, item)
+			}
+		}
+		offset += len(page)
+
+		if limit > 0 && len(items) >= limit {
+			return items[:limit], nil
+		}
+		// an empty or short page is the last one, a page larger than the requested size means the endpoint
+		// ignores limit and returned every item.
+		if len(page) != pageSize || (totalCount > 0 && offset >= totalCount) {
+			return items, nil
+		}
+	}
+}
+
+// decodeListPage decodes a page of a list endpoint and returns its items and the total count of items, 0 when
+// unknown. Depending on the endpoint the page is an array, an object with the total count and the items, or a
+// single object when the filters identify one item.
+func decodeListPage[T any](response []byte) ([]T, int, error) {
+	response = bytes.TrimSpace(response)
+	if len(response) == 0 {
+		return nil, 0, nil
+	}
+
+	if response[0] == '[' {
+		var items []T
+		err := json.Unmarshal(response, &items)
+		return items, 0, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(response, &fields); err != nil {
+		return nil, 0, err
+	}
+
+	if _, ok := fields["Data"]; ok {
+		var page struct {
+			TotalCount int
+			Data       []T
+		}
+		err := json.Unmarshal(response, &page)
+		return page.Data, page.TotalCount, err
+	}
+
+	var item T
+	if err := json.Unmarshal(response, &item); err != nil {
+		return nil, 0, err
+	}
+	return []T{item}, 1, nil
+}
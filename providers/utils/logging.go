@@ -0,0 +1,248 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogLevels are the levels accepted in log_level.
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+// DefaultLogLevel is the log_level used when the attribute is not set, TF_LOG_PROVIDER still decides which
+// messages Terraform shows.
+const DefaultLogLevel = "debug"
+
+// redactedValue replaces secrets in log messages.
+const redactedValue = "****"
+
+// logRedactions replace API keys, tokens, cookies and credential payloads in log messages.
+var logRedactions = []struct {
+	expression  *regexp.Regexp
+	replacement string
+}{
+	// PS-Auth header, key=<api key>; runas=<user>;
+	{regexp.MustCompile(`(?i)(PS-Auth\s+key=)[^;\s]+`), "${1}" + redactedValue},
+	{regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`), "${1}" + redactedValue},
+	{regexp.MustCompile(`(?i)((?:set-)?cookie:\s*)[^\r\n]+`), "${1}" + redactedValue},
+	{regexp.MustCompile(`(?i)((?:ASP\.NET_SessionId|\.ASPXAUTH|session)=)[^;\s]+`), "${1}" + redactedValue},
+	// JSON payloads, "Password": "value".
+	{regexp.MustCompile(`(?i)("(?:[a-z_]*password|passphrase|credentials?|[a-z_]*secret|[a-z_]*token|api_?key|private_?key|client_?key)"\s*:\s*)"(?:[^"\\]|\\.)*"`), `${1}"` + redactedValue + `"`},
+	// form payloads, client_secret=value&.
+	{regexp.MustCompile(`(?i)\b((?:client_secret|access_token|refresh_token|password|api_key)=)[^&\s]+`), "${1}" + redactedValue},
+}
+
+// providerLoggerName is the name of the provider logger, TF_LOG_PROVIDER_PASSWORDSAFE sets its level as
+// it does for the loggers of the plugin server.
+const providerLoggerName = "passwordsafe"
+
+// providerLog holds the logging settings shared by both providers. log_level and log_file apply to the whole
+// provider process, configured is set once a provider configuration applied them.
+type providerLog struct {
+	mu         sync.RWMutex
+	ctx        context.Context
+	level      zap.AtomicLevel
+	file       *os.File
+	fileName   string
+	configured bool
+	secrets    map[string]struct{}
+}
+
+var sharedLog = &providerLog{ctx: newLoggerContext(), level: zap.NewAtomicLevelAt(zapcore.DebugLevel), secrets: map[string]struct{}{}}
+
+// newLoggerContext returns the long-lived context tflog writes to. The context of a request is not used, the
+// logger is shared by every request and is used after the request that configured it finished.
+func newLoggerContext() context.Context {
+	return tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithStderrFromInit(),
+		tfsdklog.WithLogName(providerLoggerName),
+		tfsdklog.WithLevelFromEnv("TF_LOG_PROVIDER", providerLoggerName),
+	)
+}
+
+// NewProviderLogger returns the logger of the providers and the client library. Messages are redacted and
+// sent to tflog, and to log_file when it is set.
+func NewProviderLogger() logging.Logger {
+	return logging.NewZapLogger(zap.New(&tflogCore{log: sharedLog}))
+}
+
+// ConfigureLogging applies the log_level and log_file attributes. secrets are the configured credentials, they
+// are redacted wherever they appear, along with the secrets of the providers configured before. Aliases and
+// both muxed providers share the logger, so every configuration must set the same log_level and log_file.
+func ConfigureLogging(level string, fileName string, secrets ...string) error {
+	zapLevel, err := zapcore.ParseLevel(cmp.Or(level, DefaultLogLevel))
+	if err != nil || !slices.Contains(LogLevels, zapLevel.String()) {
+		return fmt.Errorf("invalid log_level %q, must be one of %v", level, strings.Join(LogLevels, ", "))
+	}
+
+	sharedLog.mu.Lock()
+	defer sharedLog.mu.Unlock()
+
+	if sharedLog.configured {
+		if zapLevel != sharedLog.level.Level() || fileName != sharedLog.fileName {
+			return fmt.Errorf("log_level and log_file must be the same in every configuration of the provider, they are already set to %q and %q",
+				sharedLog.level.Level().String(), sharedLog.fileName)
+		}
+		sharedLog.addSecrets(secrets)
+		return nil
+	}
+
+	file, err := openLogFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	sharedLog.level.SetLevel(zapLevel)
+	sharedLog.file = file
+	sharedLog.fileName = fileName
+	sharedLog.configured = true
+	sharedLog.addSecrets(secrets)
+	return nil
+}
+
+// AddLogSecrets redacts the given values from the logs, e.g. the credentials returned by credentials_command.
+// Secrets are kept until the provider exits, aliases and both muxed providers log through the same logger.
+func AddLogSecrets(secrets ...string) {
+	sharedLog.mu.Lock()
+	defer sharedLog.mu.Unlock()
+
+	sharedLog.addSecrets(secrets)
+}
+
+func (l *providerLog) addSecrets(secrets []string) {
+	for _, secret := range secrets {
+		if strings.TrimSpace(secret) != "" {
+			l.secrets[secret] = struct{}{}
+		}
+	}
+}
+
+func openLogFile(fileName string) (*os.File, error) {
+	if fileName == "" {
+		return nil, nil
+	}
+
+	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening log_file: %w", err)
+	}
+	return file, nil
+}
+
+// RedactLogMessage replaces API keys, tokens, cookies and credential payloads in a log message.
+func RedactLogMessage(message string) string {
+	for _, redaction := range logRedactions {
+		message = redaction.expression.ReplaceAllString(message, redaction.replacement)
+	}
+	return message
+}
+
+// redact replaces the configured credentials and the known secret formats.
+func (l *providerLog) redact(message string) string {
+	// longer secrets first, a secret containing another one is then redacted as a whole.
+	secrets := slices.SortedFunc(maps.Keys(l.secrets), func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	for _, secret := range secrets {
+		message = strings.ReplaceAll(message, secret, redactedValue)
+	}
+	return RedactLogMessage(message)
+}
+
+// tflogCore is the zap core bridging the client library logger to tflog.
+type tflogCore struct {
+	log    *providerLog
+	fields []zapcore.Field
+}
+
+func (c *tflogCore) Enabled(level zapcore.Level) bool {
+	return c.log.level.Enabled(level)
+}
+
+func (c *tflogCore) With(fields []zapcore.Field) zapcore.Core {
+	return &tflogCore{log: c.log, fields: append(slices.Clone(c.fields), fields...)}
+}
+
+func (c *tflogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *tflogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	c.log.mu.RLock()
+	defer c.log.mu.RUnlock()
+
+	entry.Message = c.log.redact(entry.Message)
+	additionalFields := c.redactedFields(append(slices.Clone(c.fields), fields...))
+
+	switch entry.Level {
+	case zapcore.DebugLevel:
+		tflog.Debug(c.log.ctx, entry.Message, additionalFields)
+	case zapcore.InfoLevel:
+		tflog.Info(c.log.ctx, entry.Message, additionalFields)
+	case zapcore.WarnLevel:
+		tflog.Warn(c.log.ctx, entry.Message, additionalFields)
+	default:
+		tflog.Error(c.log.ctx, entry.Message, additionalFields)
+	}
+
+	return c.writeFile(entry, additionalFields)
+}
+
+func (c *tflogCore) redactedFields(fields []zapcore.Field) map[string]interface{} {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+
+	for key, value := range encoder.Fields {
+		encoder.Fields[key] = c.log.redact(fmt.Sprint(value))
+	}
+	return encoder.Fields
+}
+
+// writeFile appends the entry to log_file as a JSON line.
+func (c *tflogCore) writeFile(entry zapcore.Entry, fields map[string]interface{}) error {
+	if c.log.file == nil {
+		return nil
+	}
+
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	zapFields := make([]zapcore.Field, 0, len(fields))
+	for key, value := range fields {
+		zapFields = append(zapFields, zap.Any(key, value))
+	}
+
+	buffer, err := encoder.EncodeEntry(entry, zapFields)
+	if err != nil {
+		return err
+	}
+	defer buffer.Free()
+
+	_, err = c.log.file.Write(buffer.Bytes())
+	return err
+}
+
+func (c *tflogCore) Sync() error {
+	c.log.mu.RLock()
+	defer c.log.mu.RUnlock()
+
+	if c.log.file == nil {
+		return nil
+	}
+	return c.log.file.Sync()
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+	managed_accounts "github.com/BeyondTrust/go-client-library-passwordsafe/api/managed_account"
+)
+
+// GetManagedAccountByID gets a managed account by managed account ID.
+func GetManagedAccountByID(authenticationObj auth.AuthenticationObj, managedAccountID int, zapLogger logging.Logger) (entities.ManagedAccountDetails, error) {
+	managedAccountUrl := authenticationObj.ApiUrl.JoinPath("ManagedAccounts", strconv.Itoa(managedAccountID)).String()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", managedAccountUrl, "", "GetManagedAccountByID", zapLogger)
+	if err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	var managedAccount entities.ManagedAccountDetails
+	if err = json.Unmarshal(response, &managedAccount); err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	return managedAccount, nil
+}
+
+// GetManagedAccountBySystemID gets a managed account by managed system ID and account name.
+func GetManagedAccountBySystemID(authenticationObj auth.AuthenticationObj, managedSystemID int, accountName string, zapLogger logging.Logger) (entities.ManagedAccountDetails, error) {
+	v := url.Values{}
+	v.Add("name", accountName)
+
+	managedAccountUrl := authenticationObj.ApiUrl.JoinPath("ManagedSystems", strconv.Itoa(managedSystemID), "ManagedAccounts").String() + "?" + v.Encode()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", managedAccountUrl, "", "GetManagedAccountBySystemID", zapLogger)
+	if err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	var managedAccount entities.ManagedAccountDetails
+	if err = json.Unmarshal(response, &managedAccount); err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	if managedAccount.ManagedAccountID == 0 {
+		return entities.ManagedAccountDetails{}, fmt.Errorf("managed account %v was not found in managed system %v", accountName, managedSystemID)
+	}
+
+	return managedAccount, nil
+}
+
+// CreateManagedAccountAccessRequest creates an access request for a managed account and returns the request ID.
+func CreateManagedAccountAccessRequest(authenticationObj auth.AuthenticationObj, managedSystemID int, managedAccountID int, zapLogger logging.Logger) (string, error) {
+	manageAccountObj, err := managed_accounts.NewManagedAccountObj(authenticationObj, zapLogger)
+	if err != nil {
+		return "", err
+	}
+	return manageAccountObj.ManagedAccountCreateRequest(managedSystemID, managedAccountID, authenticationObj.ApiUrl.JoinPath("Requests").String())
+}
+
+// GetCredentialByRequest gets the credential of an approved access request and checks the request in. The request
+// is checked in as well when the credential cannot be retrieved, it would stay checked out otherwise.
+func GetCredentialByRequest(authenticationObj auth.AuthenticationObj, requestID string, zapLogger logging.Logger) (secretValue string, err error) {
+	manageAccountObj, err := managed_accounts.NewManagedAccountObj(authenticationObj, zapLogger)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_, checkinErr := manageAccountObj.ManagedAccountRequestCheckIn(requestID, authenticationObj.ApiUrl.JoinPath("Requests", requestID, "checkin").String())
+		if checkinErr != nil {
+			secretValue, err = "", errors.Join(err, fmt.Errorf("error checking in access request %v: %w", requestID, checkinErr))
+		}
+	}()
+
+	credential, err := manageAccountObj.CredentialByRequestId(requestID, authenticationObj.ApiUrl.JoinPath("Credentials", requestID).String())
+	if err != nil {
+		return "", err
+	}
+
+	// the credential is returned as a JSON string, the raw value is used when it is not quoted.
+	secretValue, err = strconv.Unquote(credential)
+	if err != nil {
+		return credential, nil
+	}
+	return secretValue, nil
+}
+
+// GetManagedAccountCredential requests a managed account by IDs and returns its credential.
+func GetManagedAccountCredential(authenticationObj auth.AuthenticationObj, managedAccount entities.ManagedAccountDetails, zapLogger logging.Logger) (string, error) {
+	requestID, err := CreateManagedAccountAccessRequest(authenticationObj, managedAccount.ManagedSystemID, managedAccount.ManagedAccountID, zapLogger)
+	if err != nil {
+		return "", err
+	}
+	return GetCredentialByRequest(authenticationObj, requestID, zapLogger)
+}
+
+// change states returned by the ManagedAccounts endpoint.
+const (
+	ChangeStateIdle     = 0
+	ChangeStateChanging = 1
+	ChangeStateQueued   = 2
+)
+
+// GetManagedAccountByName gets a managed account by system name and account name.
+func GetManagedAccountByName(authenticationObj auth.AuthenticationObj, systemName string, accountName string, zapLogger logging.Logger) (entities.ManagedAccountDetails, error) {
+	manageAccountObj, err := managed_accounts.NewManagedAccountObj(authenticationObj, zapLogger)
+	if err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	v := url.Values{}
+	v.Add("systemName", systemName)
+	v.Add("accountName", accountName)
+
+	managedAccount, err := manageAccountObj.ManagedAccountGet(systemName, accountName, authenticationObj.ApiUrl.JoinPath("ManagedAccounts").String()+"?"+v.Encode())
+	if err != nil {
+		return entities.ManagedAccountDetails{}, err
+	}
+
+	return GetManagedAccountByID(authenticationObj, managedAccount.AccountId, zapLogger)
+}
+
+// QueueManagedAccountCredentialChange queues a password change of a managed account.
+func QueueManagedAccountCredentialChange(authenticationObj auth.AuthenticationObj, managedAccountID int, zapLogger logging.Logger) error {
+	changeUrl := authenticationObj.ApiUrl.JoinPath("ManagedAccounts", strconv.Itoa(managedAccountID), "Credentials", "Change").String()
+	_, err := callPasswordSafeAPI(authenticationObj, "POST", changeUrl, `{"Queue": true}`, "QueueManagedAccountCredentialChange", zapLogger)
+	return err
+}
+
+// IsManagedAccountChanging returns true while a password change of the managed account is queued or running.
+func IsManagedAccountChanging(managedAccount entities.ManagedAccountDetails) bool {
+	return managedAccount.IsChanging || managedAccount.ChangeState != ChangeStateIdle
+}
+
+// WaitForManagedAccountChange polls a managed account every pollInterval until its password change is no
+// longer queued or running, the timeout expires or the context is cancelled.
+func WaitForManagedAccountChange(ctx context.Context, authenticationObj auth.AuthenticationObj, managedAccountID int, timeout time.Duration, pollInterval time.Duration, zapLogger logging.Logger) (entities.ManagedAccountDetails, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		managedAccount, err := GetManagedAccountByID(authenticationObj, managedAccountID, zapLogger)
+		if err != nil {
+			return managedAccount, err
+		}
+
+		if !IsManagedAccountChanging(managedAccount) {
+			return managedAccount, nil
+		}
+
+		zapLogger.Info(fmt.Sprintf("password change of managed account %v is in progress, change state %v", managedAccountID, managedAccount.ChangeState))
+
+		if time.Now().Add(pollInterval).After(deadline) {
+			return managedAccount, fmt.Errorf("timed out after %v waiting for the password change of managed account %v", timeout, managedAccountID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return managedAccount, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
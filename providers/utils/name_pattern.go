@@ -0,0 +1,31 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// NamePattern matches names against a case insensitive pattern where * matches any sequence of characters
+// and ? matches a single character. The zero value and the empty pattern match every name.
+type NamePattern struct {
+	expression *regexp.Regexp
+}
+
+// NewNamePattern returns the NamePattern of pattern.
+func NewNamePattern(pattern string) NamePattern {
+	if pattern == "" {
+		return NamePattern{}
+	}
+
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+	return NamePattern{expression: regexp.MustCompile("(?is)^" + expression + "$")}
+}
+
+// Match returns true when name matches the pattern.
+func (p NamePattern) Match(name string) bool {
+	return p.expression == nil || p.expression.MatchString(name)
+}
@@ -0,0 +1,448 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// Character class requirements of a password rule.
+const (
+	CharactersRequired   = "required"
+	CharactersPermitted  = "permitted"
+	CharactersNotAllowed = "not_allowed"
+)
+
+// First character rules of a password rule.
+const (
+	FirstCharacterAny     = "any"
+	FirstCharacterLetter  = "letter"
+	FirstCharacterNumeric = "numeric"
+)
+
+const (
+	lowercaseCharacters = "abcdefghijklmnopqrstuvwxyz"
+	uppercaseCharacters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	numericCharacters   = "0123456789"
+	// DefaultPasswordSymbols are the symbols used when a rule does not list its allowed symbols.
+	DefaultPasswordSymbols = "!#$%&()*+,-.:;<=>?@[]^_{}~"
+	// DefaultPasswordLength is the password length used when a rule does not set a length range.
+	DefaultPasswordLength = 16
+	maxPasswordLength     = 256
+)
+
+// CharacterRequirements are the values accepted in the lowercase, uppercase, numeric and symbols rule constraints.
+var CharacterRequirements = []string{CharactersRequired, CharactersPermitted, CharactersNotAllowed}
+
+// FirstCharacterRules are the values accepted in the first_character rule constraint.
+var FirstCharacterRules = []string{FirstCharacterAny, FirstCharacterLetter, FirstCharacterNumeric}
+
+// PasswordRuleConstraints are the constraint names accepted by ParsePasswordRule.
+var PasswordRuleConstraints = []string{
+	"min_length", "max_length",
+	"lowercase", "uppercase", "numeric", "symbols",
+	"min_lowercase", "min_uppercase", "min_numeric", "min_symbols",
+	"allowed_symbols", "first_character", "excluded_characters",
+}
+
+// CharacterClass is a class of characters of a password rule.
+type CharacterClass struct {
+	Requirement string
+	Minimum     int
+	Characters  string
+}
+
+// PasswordRule holds the constraints of a Password Safe password rule.
+type PasswordRule struct {
+	MinimumLength      int
+	MaximumLength      int
+	Lowercase          CharacterClass
+	Uppercase          CharacterClass
+	Numeric            CharacterClass
+	Symbols            CharacterClass
+	FirstCharacter     string
+	ExcludedCharacters string
+}
+
+// NewPasswordRule returns the default rule: 16 characters with lowercase, uppercase and numeric characters, and symbols permitted.
+func NewPasswordRule() PasswordRule {
+	return PasswordRule{
+		MinimumLength:  DefaultPasswordLength,
+		MaximumLength:  DefaultPasswordLength,
+		Lowercase:      CharacterClass{Requirement: CharactersRequired, Characters: lowercaseCharacters},
+		Uppercase:      CharacterClass{Requirement: CharactersRequired, Characters: uppercaseCharacters},
+		Numeric:        CharacterClass{Requirement: CharactersRequired, Characters: numericCharacters},
+		Symbols:        CharacterClass{Requirement: CharactersPermitted, Characters: DefaultPasswordSymbols},
+		FirstCharacter: FirstCharacterAny,
+	}
+}
+
+// ParsePasswordRule returns the rule with the given constraints applied to the default rule, the constraint names
+// are listed in PasswordRuleConstraints.
+func ParsePasswordRule(constraints map[string]string) (PasswordRule, error) {
+	rule := NewPasswordRule()
+	lengthSet := false
+
+	for name, value := range constraints {
+		var err error
+		switch name {
+		case "min_length":
+			rule.MinimumLength, err = parseConstraint(name, value)
+			lengthSet = true
+		case "max_length":
+			rule.MaximumLength, err = parseConstraint(name, value)
+			lengthSet = true
+		case "allowed_symbols":
+			rule.Symbols.Characters = value
+		case "first_character":
+			rule.FirstCharacter = value
+		case "excluded_characters":
+			rule.ExcludedCharacters = value
+		default:
+			err = rule.setClassConstraint(name, value)
+		}
+		if err != nil {
+			return PasswordRule{}, err
+		}
+	}
+
+	// a single length bound sets both bounds.
+	if lengthSet {
+		rule.MinimumLength, rule.MaximumLength = passwordLengthRange(constraints, rule)
+	}
+	return rule, rule.Validate()
+}
+
+func (rule *PasswordRule) setClassConstraint(name string, value string) error {
+	classes := map[string]*CharacterClass{
+		"lowercase": &rule.Lowercase,
+		"uppercase": &rule.Uppercase,
+		"numeric":   &rule.Numeric,
+		"symbols":   &rule.Symbols,
+	}
+
+	if class, ok := classes[name]; ok {
+		class.Requirement = value
+		return nil
+	}
+
+	if class, ok := classes[strings.TrimPrefix(name, "min_")]; ok && strings.HasPrefix(name, "min_") {
+		minimum, err := parseConstraint(name, value)
+		class.Minimum = minimum
+		return err
+	}
+
+	return fmt.Errorf("unknown password rule constraint %q, valid constraints are %v", name, strings.Join(PasswordRuleConstraints, ", "))
+}
+
+func parseConstraint(name string, value string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%v must be a number, got %q", name, value)
+	}
+	return number, nil
+}
+
+func passwordLengthRange(constraints map[string]string, rule PasswordRule) (int, int) {
+	_, minimumSet := constraints["min_length"]
+	_, maximumSet := constraints["max_length"]
+	switch {
+	case !minimumSet:
+		return min(rule.MaximumLength, DefaultPasswordLength), rule.MaximumLength
+	case !maximumSet:
+		return rule.MinimumLength, max(rule.MinimumLength, DefaultPasswordLength)
+	}
+	return rule.MinimumLength, rule.MaximumLength
+}
+
+// Validate checks the rule can be satisfied.
+func (rule PasswordRule) Validate() error {
+	if rule.MinimumLength < 1 || rule.MaximumLength < rule.MinimumLength || rule.MaximumLength > maxPasswordLength {
+		return fmt.Errorf("invalid password length range %v-%v, valid lengths are between 1 and %v", rule.MinimumLength, rule.MaximumLength, maxPasswordLength)
+	}
+
+	if !slices.Contains(FirstCharacterRules, rule.FirstCharacter) {
+		return fmt.Errorf("first_character must be one of %v, got %q", strings.Join(FirstCharacterRules, ", "), rule.FirstCharacter)
+	}
+
+	required := 0
+	for _, class := range rule.classes() {
+		if err := rule.validateClass(class); err != nil {
+			return err
+		}
+		required += rule.minimum(class.CharacterClass)
+	}
+
+	if required > rule.MaximumLength {
+		return fmt.Errorf("the rule requires %v characters but the maximum length is %v", required, rule.MaximumLength)
+	}
+	if rule.firstCharacters() == "" {
+		return fmt.Errorf("no allowed character matches first_character %q", rule.FirstCharacter)
+	}
+	return nil
+}
+
+func (rule PasswordRule) validateClass(class namedCharacterClass) error {
+	switch {
+	case !slices.Contains(CharacterRequirements, class.Requirement):
+		return fmt.Errorf("%v must be one of %v, got %q", class.name, strings.Join(CharacterRequirements, ", "), class.Requirement)
+	case class.Minimum < 0:
+		return fmt.Errorf("min_%v cannot be negative", class.name)
+	case class.Requirement == CharactersRequired && rule.allowed(class.CharacterClass) == "":
+		return fmt.Errorf("%v characters are required but all of them are excluded", class.name)
+	}
+	return nil
+}
+
+type namedCharacterClass struct {
+	name string
+	CharacterClass
+}
+
+// classes returns the character classes in a fixed order, so a seeded password does not change.
+func (rule PasswordRule) classes() []namedCharacterClass {
+	return []namedCharacterClass{
+		{"lowercase", rule.Lowercase},
+		{"uppercase", rule.Uppercase},
+		{"numeric", rule.Numeric},
+		{"symbols", rule.Symbols},
+	}
+}
+
+// allowed returns the characters of the class that can be used in the password.
+func (rule PasswordRule) allowed(class CharacterClass) string {
+	if class.Requirement == CharactersNotAllowed {
+		return ""
+	}
+
+	var characters strings.Builder
+	for _, character := range class.Characters {
+		if !strings.ContainsRune(rule.ExcludedCharacters, character) && !strings.ContainsRune(characters.String(), character) {
+			characters.WriteRune(character)
+		}
+	}
+	return characters.String()
+}
+
+// minimum returns the number of characters of the class the password must contain.
+func (rule PasswordRule) minimum(class CharacterClass) int {
+	if class.Requirement != CharactersRequired {
+		return 0
+	}
+	return max(class.Minimum, 1)
+}
+
+func (rule PasswordRule) firstCharacters() string {
+	letters := rule.allowed(rule.Lowercase) + rule.allowed(rule.Uppercase)
+	switch rule.FirstCharacter {
+	case FirstCharacterLetter:
+		return letters
+	case FirstCharacterNumeric:
+		return rule.allowed(rule.Numeric)
+	}
+	return letters + rule.allowed(rule.Numeric) + rule.allowed(rule.Symbols)
+}
+
+// GeneratePassword returns a password complying with the rule, the characters are picked with random, which must
+// be crypto/rand.Reader unless the password is derived from a seed.
+func GeneratePassword(rule PasswordRule, random io.Reader) (string, error) {
+	if err := rule.Validate(); err != nil {
+		return "", err
+	}
+
+	lengthOffset, err := randomInt(random, rule.MaximumLength-rule.MinimumLength+1)
+	if err != nil {
+		return "", err
+	}
+	length := rule.MinimumLength + lengthOffset
+
+	first, err := randomCharacter(random, rule.firstCharacters())
+	if err != nil {
+		return "", err
+	}
+
+	pools := rule.requiredPools(first)
+	// the required characters may make the password longer than the random length, never longer than the maximum.
+	if len(pools)+1 > rule.MaximumLength {
+		return "", fmt.Errorf("the rule requires %v characters with first_character %q but the maximum length is %v", len(pools)+1, rule.FirstCharacter, rule.MaximumLength)
+	}
+
+	all := rule.allowed(rule.Lowercase) + rule.allowed(rule.Uppercase) + rule.allowed(rule.Numeric) + rule.allowed(rule.Symbols)
+	for len(pools) < length-1 {
+		pools = append(pools, all)
+	}
+
+	password := []rune{first}
+	for _, pool := range pools {
+		character, err := randomCharacter(random, pool)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, character)
+	}
+
+	// shuffling every character but the first one, so the required characters are not grouped.
+	if err = shuffle(random, password[1:]); err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// requiredPools returns the characters of each character required by the rule, minus the first character when
+// it belongs to their class.
+func (rule PasswordRule) requiredPools(first rune) []string {
+	var pools []string
+	for _, class := range rule.classes() {
+		allowed := rule.allowed(class.CharacterClass)
+		required := rule.minimum(class.CharacterClass)
+		if strings.ContainsRune(allowed, first) {
+			required--
+		}
+		for range required {
+			pools = append(pools, allowed)
+		}
+	}
+	return pools
+}
+
+func shuffle(random io.Reader, characters []rune) error {
+	for i := len(characters) - 1; i > 0; i-- {
+		j, err := randomInt(random, i+1)
+		if err != nil {
+			return err
+		}
+		characters[i], characters[j] = characters[j], characters[i]
+	}
+	return nil
+}
+
+// randomInt returns a uniform random number in [0, n).
+func randomInt(random io.Reader, n int) (int, error) {
+	if n <= 1 {
+		return 0, nil
+	}
+	value, err := rand.Int(random, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("error generating password: %w", err)
+	}
+	return int(value.Int64()), nil
+}
+
+func randomCharacter(random io.Reader, characters string) (rune, error) {
+	runes := []rune(characters)
+	index, err := randomInt(random, len(runes))
+	if err != nil {
+		return 0, err
+	}
+	return runes[index], nil
+}
+
+// seededReader is a deterministic random stream, HMAC-SHA256 of a counter keyed by the seed.
+type seededReader struct {
+	key     []byte
+	counter uint64
+	buffer  []byte
+}
+
+// NewSeededReader returns a random stream derived from seed, the same seed always returns the same stream.
+func NewSeededReader(seed string) (io.Reader, error) {
+	if seed == "" {
+		return nil, errors.New("seed cannot be empty")
+	}
+	return &seededReader{key: []byte(seed)}, nil
+}
+
+func (r *seededReader) Read(p []byte) (int, error) {
+	read := 0
+	for read < len(p) {
+		if len(r.buffer) == 0 {
+			mac := hmac.New(sha256.New, r.key)
+			_ = binary.Write(mac, binary.BigEndian, r.counter)
+			r.counter++
+			r.buffer = mac.Sum(nil)
+		}
+		n := copy(p[read:], r.buffer)
+		r.buffer = r.buffer[n:]
+		read += n
+	}
+	return read, nil
+}
+
+// requirementFromAPI converts the (N)ot allowed, (P)ermitted and (R)equired requirements of the PasswordRules endpoint.
+func requirementFromAPI(requirement string) string {
+	switch strings.ToUpper(requirement) {
+	case "N":
+		return CharactersNotAllowed
+	case "R":
+		return CharactersRequired
+	}
+	return CharactersPermitted
+}
+
+// firstCharacterFromAPI converts the (C)haracters, (N)umerals and (A)ny first character requirements of the PasswordRules endpoint.
+func firstCharacterFromAPI(requirement string) string {
+	switch strings.ToUpper(requirement) {
+	case "C":
+		return FirstCharacterLetter
+	case "N":
+		return FirstCharacterNumeric
+	}
+	return FirstCharacterAny
+}
+
+// NewPasswordRuleFromAPI converts a rule returned by the PasswordRules endpoint.
+func NewPasswordRuleFromAPI(apiRule entities.PasswordRule) PasswordRule {
+	class := func(requirement string, minimum int, valid []string, defaultCharacters string) CharacterClass {
+		characters := strings.Join(valid, "")
+		if characters == "" {
+			characters = defaultCharacters
+		}
+		return CharacterClass{Requirement: requirementFromAPI(requirement), Minimum: minimum, Characters: characters}
+	}
+
+	return PasswordRule{
+		MinimumLength:  apiRule.MinimumLength,
+		MaximumLength:  apiRule.MaximumLength,
+		Lowercase:      class(apiRule.LowercaseRequirement, apiRule.MinimumLowercaseCharacters, apiRule.ValidLowercaseCharacters, lowercaseCharacters),
+		Uppercase:      class(apiRule.UppercaseRequirement, apiRule.MinimumUppercaseCharacters, apiRule.ValidUppercaseCharacters, uppercaseCharacters),
+		Numeric:        class(apiRule.NumericRequirement, apiRule.MinimumNumericCharacters, nil, numericCharacters),
+		Symbols:        class(apiRule.SymbolRequirement, apiRule.MinimumSymbols, apiRule.ValidSymbols, DefaultPasswordSymbols),
+		FirstCharacter: firstCharacterFromAPI(apiRule.FirstCharacterRequirement),
+	}
+}
+
+// GetPasswordRule gets a password rule by ID.
+func GetPasswordRule(authenticationObj auth.AuthenticationObj, passwordRuleID int, zapLogger logging.Logger) (entities.PasswordRule, error) {
+	passwordRuleUrl := authenticationObj.ApiUrl.JoinPath("PasswordRules", strconv.Itoa(passwordRuleID)).String()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", passwordRuleUrl, "", "GetPasswordRule", zapLogger)
+	if err != nil {
+		return entities.PasswordRule{}, err
+	}
+
+	var passwordRule entities.PasswordRule
+	if err = json.Unmarshal(response, &passwordRule); err != nil {
+		return entities.PasswordRule{}, err
+	}
+
+	if passwordRule.PasswordRuleID == 0 {
+		return entities.PasswordRule{}, fmt.Errorf("password rule %v was not found", passwordRuleID)
+	}
+
+	return passwordRule, nil
+}
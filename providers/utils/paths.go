@@ -0,0 +1,140 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultSeparator is the separator used when none is set, the same default as the secret and managed account lookups.
+const DefaultSeparator = "/"
+
+// pathLimits are the limits the client library checks before looking up a secret or a managed account.
+type pathLimits struct {
+	pathName     string
+	name         string
+	maxPath      int
+	maxName      int
+	maxPathDepth int
+}
+
+var (
+	secretPathLimits         = pathLimits{pathName: "path", name: "title", maxPath: 1792, maxName: 256, maxPathDepth: 7}
+	managedAccountPathLimits = pathLimits{pathName: "system name", name: "account name", maxPath: 128, maxName: 245, maxPathDepth: 1}
+)
+
+// SecretPath joins a folder path and a secret title into the secret path used by the secret lookups.
+func SecretPath(folderPath string, title string, separator string) (string, error) {
+	return joinPath(folderPath, title, separator, secretPathLimits)
+}
+
+// ParseSecretPath splits a secret path into its folder path and title, the last element of the path is the title.
+func ParseSecretPath(secretPath string, separator string) (string, string, error) {
+	return splitPath(secretPath, separator, secretPathLimits)
+}
+
+// ManagedAccountPath joins a system name and an account name into the managed account path used by the
+// managed account lookups.
+func ManagedAccountPath(systemName string, accountName string, separator string) (string, error) {
+	return joinPath(systemName, accountName, separator, managedAccountPathLimits)
+}
+
+// ParseManagedAccountPath splits a managed account path into its system name and account name.
+func ParseManagedAccountPath(managedAccountPath string, separator string) (string, string, error) {
+	return splitPath(managedAccountPath, separator, managedAccountPathLimits)
+}
+
+// NormalizeFolderPath trims the whitespace around every folder of the path and drops empty folders, so
+// leading, trailing and duplicate separators are removed the way Password Safe stores folder paths.
+func NormalizeFolderPath(folderPath string, separator string) (string, error) {
+	separator, err := checkSeparator(separator)
+	if err != nil {
+		return "", err
+	}
+
+	var folders []string
+	for _, folder := range strings.Split(folderPath, separator) {
+		if folder = strings.TrimSpace(folder); folder != "" {
+			folders = append(folders, folder)
+		}
+	}
+
+	if len(folders) == 0 {
+		return "", fmt.Errorf("folder path %q does not contain any folder", folderPath)
+	}
+	return strings.Join(folders, separator), nil
+}
+
+// checkSeparator returns the default separator when separator is empty. Whitespace separators are rejected,
+// the path elements are trimmed.
+func checkSeparator(separator string) (string, error) {
+	if separator == "" {
+		return DefaultSeparator, nil
+	}
+	if strings.TrimSpace(separator) == "" {
+		return "", errors.New("separator cannot be whitespace")
+	}
+	return separator, nil
+}
+
+func joinPath(path string, name string, separator string, limits pathLimits) (string, error) {
+	separator, err := checkSeparator(separator)
+	if err != nil {
+		return "", err
+	}
+
+	name = strings.TrimSpace(name)
+	if strings.Contains(name, separator) {
+		return "", fmt.Errorf("%s %q contains the separator %q, use a different separator", limits.name, name, separator)
+	}
+	if limits.maxPathDepth == 1 && strings.Contains(path, separator) {
+		return "", fmt.Errorf("%s %q contains the separator %q, use a different separator", limits.pathName, path, separator)
+	}
+
+	return validatePath(path, name, separator, limits)
+}
+
+func splitPath(value string, separator string, limits pathLimits) (string, string, error) {
+	separator, err := checkSeparator(separator)
+	if err != nil {
+		return "", "", err
+	}
+
+	index := strings.LastIndex(value, separator)
+	if index < 0 {
+		return "", "", fmt.Errorf("%q does not contain the separator %q, expected <%s>%s<%s>", value, separator, limits.pathName, separator, limits.name)
+	}
+
+	path, name := value[:index], strings.TrimSpace(value[index+len(separator):])
+	joined, err := validatePath(path, name, separator, limits)
+	if err != nil {
+		return "", "", err
+	}
+	return joined[:len(joined)-len(separator)-len(name)], name, nil
+}
+
+// validatePath checks the path and name against the client library limits and returns the joined path.
+// Folder paths are normalized, a system name is only trimmed.
+func validatePath(path string, name string, separator string, limits pathLimits) (string, error) {
+	path = strings.TrimSpace(path)
+	if limits.maxPathDepth > 1 && path != "" {
+		normalized, err := NormalizeFolderPath(path, separator)
+		if err != nil {
+			return "", err
+		}
+		path = normalized
+	}
+
+	switch {
+	case path == "" || len(path) > limits.maxPath:
+		return "", fmt.Errorf("invalid %s length=%v, valid length between 1 and %v", limits.pathName, len(path), limits.maxPath)
+	case name == "" || len(name) > limits.maxName:
+		return "", fmt.Errorf("invalid %s length=%v, valid length between 1 and %v", limits.name, len(name), limits.maxName)
+	case strings.Count(path, separator)+1 > limits.maxPathDepth:
+		return "", fmt.Errorf("invalid %s depth=%v, valid depth is %v", limits.pathName, strings.Count(path, separator)+1, limits.maxPathDepth)
+	}
+
+	return path + separator + name, nil
+}
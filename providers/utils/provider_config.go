@@ -0,0 +1,208 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ProviderConfigAttributes are the provider attributes checked by ValidateProviderConfig.
+var ProviderConfigAttributes = []string{
+	"api_key",
+	"client_id",
+	"client_secret",
+	"url",
+	"api_version",
+	"api_account_name",
+	"client_certificate_name",
+	"client_certificates_folder_path",
+	"credentials_command",
+}
+
+// supportedAPIVersions are the Password Safe API versions accepted in api_version.
+var supportedAPIVersions = []string{"3.0", "3.1"}
+
+// ProviderConfig holds the provider attribute values, with the PS_* environment variables applied, checked by
+// ValidateProviderConfig. Attributes whose value is not known yet are not checked.
+type ProviderConfig struct {
+	// RequireCredentials reports missing credentials and url. It is only set when configuring the provider,
+	// so terraform validate keeps working without credentials.
+	RequireCredentials bool
+	values             map[string]string
+	unknown            map[string]bool
+}
+
+// ConfigError is a provider configuration error, Attribute is empty when the error is not about a single attribute.
+type ConfigError struct {
+	Attribute string
+	Summary   string
+	Detail    string
+}
+
+// NewProviderConfig returns an empty provider configuration.
+func NewProviderConfig() ProviderConfig {
+	return ProviderConfig{values: map[string]string{}, unknown: map[string]bool{}}
+}
+
+// Set sets the value of an attribute.
+func (config ProviderConfig) Set(attribute string, value string) {
+	config.values[attribute] = strings.TrimSpace(value)
+}
+
+// SetUnknown marks an attribute whose value is only known after apply.
+func (config ProviderConfig) SetUnknown(attribute string) {
+	config.unknown[attribute] = true
+}
+
+func (config ProviderConfig) value(attribute string) string {
+	return config.values[attribute]
+}
+
+func (config ProviderConfig) isSet(attribute string) bool {
+	return config.values[attribute] != ""
+}
+
+// known returns true when the value of every attribute is known.
+func (config ProviderConfig) known(attributes ...string) bool {
+	for _, attribute := range attributes {
+		if config.unknown[attribute] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateProviderConfig checks the provider configuration shared by both providers: the authentication
+// method, attributes required together or conflicting with each other, and the url and api_version formats.
+func ValidateProviderConfig(config ProviderConfig) []ConfigError {
+	var configErrors []ConfigError
+	for _, validate := range []func(ProviderConfig) []ConfigError{
+		validateAuthenticationMethod,
+		validateClientCredentials,
+		validateAPIKey,
+		validateURL,
+		validateAPIVersion,
+		validateClientCertificateFile,
+	} {
+		configErrors = append(configErrors, validate(config)...)
+	}
+	return configErrors
+}
+
+func validateAuthenticationMethod(config ProviderConfig) []ConfigError {
+	if !config.known("api_key", "client_id", "client_secret", "credentials_command") {
+		return nil
+	}
+
+	credentialsSet := config.isSet("api_key") || config.isSet("client_id") || config.isSet("client_secret")
+
+	switch {
+	case config.isSet("credentials_command") && credentialsSet:
+		return []ConfigError{{
+			Attribute: "credentials_command",
+			Summary:   "Conflicting credentials",
+			Detail:    "credentials_command cannot be used together with api_key, client_id or client_secret.",
+		}}
+	case config.RequireCredentials && !config.isSet("credentials_command") && !credentialsSet:
+		return []ConfigError{{
+			Summary: "Missing credentials",
+			Detail:  "Set api_key and api_account_name, client_id and client_secret, or credentials_command. The credentials can also be set with the PS_API_KEY, PS_ACCOUNT_NAME, PS_CLIENT_ID and PS_CLIENT_SECRET environment variables.",
+		}}
+	case config.isSet("api_key") && (config.isSet("client_id") || config.isSet("client_secret")):
+		return []ConfigError{{
+			Attribute: "api_key",
+			Summary:   "Conflicting credentials",
+			Detail:    "api_key cannot be used together with client_id or client_secret, use either an API key or OAuth client credentials.",
+		}}
+	}
+	return nil
+}
+
+func validateClientCredentials(config ProviderConfig) []ConfigError {
+	if !config.known("client_id", "client_secret") {
+		return nil
+	}
+
+	if config.isSet("client_id") && !config.isSet("client_secret") {
+		return []ConfigError{{Attribute: "client_secret", Summary: "Missing client_secret", Detail: "client_id requires client_secret."}}
+	}
+	if config.isSet("client_secret") && !config.isSet("client_id") {
+		return []ConfigError{{Attribute: "client_id", Summary: "Missing client_id", Detail: "client_secret requires client_id."}}
+	}
+	return nil
+}
+
+func validateAPIKey(config ProviderConfig) []ConfigError {
+	if !config.known("api_key", "api_account_name") || !config.isSet("api_key") || config.isSet("api_account_name") {
+		return nil
+	}
+
+	return []ConfigError{{
+		Attribute: "api_account_name",
+		Summary:   "Missing api_account_name",
+		Detail:    "api_key requires api_account_name, the user the API calls run as.",
+	}}
+}
+
+func validateURL(config ProviderConfig) []ConfigError {
+	switch {
+	case !config.known("url"):
+		return nil
+	case !config.isSet("url") && config.RequireCredentials:
+		return []ConfigError{{Attribute: "url", Summary: "Missing url", Detail: "Set url or the PS_URL environment variable."}}
+	case !config.isSet("url"):
+		return nil
+	}
+
+	if err := checkAPIURL(config.value("url")); err != nil {
+		return []ConfigError{{Attribute: "url", Summary: "Invalid url", Detail: err.Error()}}
+	}
+	return nil
+}
+
+// checkAPIURL checks the url is the https URL of the Password Safe public API.
+func checkAPIURL(apiURL string) error {
+	parsedURL, err := url.Parse(apiURL)
+	if err != nil {
+		return fmt.Errorf("url is not a valid URL: %w", err)
+	}
+
+	if parsedURL.Scheme != "https" || parsedURL.Host == "" {
+		return fmt.Errorf("url must be an https URL, for example https://example.com/BeyondTrust/api/public/v3, got %q", apiURL)
+	}
+
+	if !strings.Contains(parsedURL.Path, "/BeyondTrust/api/public/v") {
+		return fmt.Errorf("url must contain /BeyondTrust/api/public/v as part of the path, got %q", apiURL)
+	}
+	return nil
+}
+
+func validateAPIVersion(config ProviderConfig) []ConfigError {
+	if !config.known("api_version") || !config.isSet("api_version") || slices.Contains(supportedAPIVersions, config.value("api_version")) {
+		return nil
+	}
+
+	return []ConfigError{{
+		Attribute: "api_version",
+		Summary:   "Invalid api_version",
+		Detail:    fmt.Sprintf("api_version must be one of %v, got %q.", strings.Join(supportedAPIVersions, ", "), config.value("api_version")),
+	}}
+}
+
+func validateClientCertificateFile(config ProviderConfig) []ConfigError {
+	if !config.known("client_certificate_name", "client_certificates_folder_path") {
+		return nil
+	}
+
+	if config.isSet("client_certificate_name") && !config.isSet("client_certificates_folder_path") {
+		return []ConfigError{{
+			Attribute: "client_certificates_folder_path",
+			Summary:   "Missing client_certificates_folder_path",
+			Detail:    "client_certificate_name requires client_certificates_folder_path, the folder containing the certificate file.",
+		}}
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // GetCreateManagedSystemCommonAttributes get common attributes to create managed systems by asset, workgroup and database
@@ -81,17 +84,27 @@ func GetCreateManagedSystemCommonAttributes() map[string]schema.Attribute {
 			MarkdownDescription: "Change Frequency Type (one of: first, last, xdays)",
 			Optional:            true,
 			Computed:            true,
-			Default:             stringdefault.StaticString("first"),
+			Default:             stringdefault.StaticString(DefaultChangeFrequencyType),
+			Validators: []validator.String{
+				stringvalidator.OneOf(ChangeFrequencyTypes...),
+				ChangeFrequencyDaysRequired(),
+			},
 		},
 		"change_frequency_days": schema.Int32Attribute{
-			MarkdownDescription: "Change Frequency Days (required if ChangeFrequencyType is xdays)",
+			MarkdownDescription: "Change Frequency Days (required if ChangeFrequencyType is xdays, min: 1, max: 999)",
 			Optional:            true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 999),
+			},
 		},
 		"change_time": schema.StringAttribute{
 			MarkdownDescription: "Change Time (format: HH:MM)",
 			Optional:            true,
 			Computed:            true,
-			Default:             stringdefault.StaticString("23:30"),
+			Default:             stringdefault.StaticString(DefaultChangeTime),
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(ChangeTimeRegexp, ChangeTimeMessage),
+			},
 		},
 	}
 
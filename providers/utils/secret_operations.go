@@ -0,0 +1,96 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+)
+
+// GetSecretsList gets the secrets of the folder with folderPath and of its subfolders, or every secret when
+// folderPath is empty. Secret values are not returned.
+func GetSecretsList(authenticationObj auth.AuthenticationObj, folderPath string, separator string, zapLogger logging.Logger) ([]entities.SecretSummary, error) {
+	secretsUrl := authenticationObj.ApiUrl.JoinPath("secrets-safe/secrets").String()
+	if folderPath != "" {
+		v := url.Values{}
+		v.Add("path", folderPath)
+		v.Add("separator", separator)
+		secretsUrl += "?" + v.Encode()
+	}
+
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", secretsUrl, "", "GetSecretsList", zapLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []entities.SecretSummary
+	if err = json.Unmarshal(response, &secrets); err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// GetSecretMetadata gets the metadata of the secret with secretID. Secret values are neither decrypted nor returned.
+func GetSecretMetadata(authenticationObj auth.AuthenticationObj, secretID string, zapLogger logging.Logger) (entities.SecretMetadata, error) {
+	v := url.Values{}
+	v.Add("decrypt", "false")
+	secretUrl := authenticationObj.ApiUrl.JoinPath("secrets-safe/secrets", secretID).String() + "?" + v.Encode()
+
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", secretUrl, "", "GetSecretMetadata", zapLogger)
+	if err != nil {
+		return entities.SecretMetadata{}, err
+	}
+
+	// SecretMetadata has no field for the secret value, it is dropped when decoding.
+	var secret entities.SecretMetadata
+	if err = json.Unmarshal(response, &secret); err != nil {
+		return entities.SecretMetadata{}, err
+	}
+
+	return secret, nil
+}
+
+// GetSecretMetadataByPath gets the metadata of the secret with secretTitle in the folder with secretPath. Secret
+// values are neither decrypted nor returned.
+func GetSecretMetadataByPath(authenticationObj auth.AuthenticationObj, secretPath string, secretTitle string, separator string, zapLogger logging.Logger) (entities.SecretMetadata, error) {
+	v := url.Values{}
+	v.Add("path", secretPath)
+	v.Add("title", secretTitle)
+	v.Add("separator", separator)
+	v.Add("decrypt", "false")
+	secretsUrl := authenticationObj.ApiUrl.JoinPath("secrets-safe/secrets").String() + "?" + v.Encode()
+
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", secretsUrl, "", "GetSecretMetadataByPath", zapLogger)
+	if err != nil {
+		return entities.SecretMetadata{}, err
+	}
+
+	items, _, err := decodeListPage[entities.SecretMetadata](response)
+	if err != nil {
+		return entities.SecretMetadata{}, err
+	}
+
+	var secrets []entities.SecretMetadata
+	for _, item := range items {
+		if strings.EqualFold(item.Title, secretTitle) {
+			secrets = append(secrets, item)
+		}
+	}
+
+	switch len(secrets) {
+	case 0:
+		return entities.SecretMetadata{}, fmt.Errorf("secret %v not found in %v", secretTitle, secretPath)
+	case 1:
+		return secrets[0], nil
+	default:
+		return entities.SecretMetadata{}, fmt.Errorf("%v secrets titled %v found in %v", len(secrets), secretTitle, secretPath)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 BeyondTrust. All rights reserved.
+// Package utils.
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-passwordsafe/providers/entities"
+
+	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/logging"
+	"github.com/BeyondTrust/go-client-library-passwordsafe/api/secrets"
+)
+
+// GetSecretID gets the ID of a secret by secret path and title.
+func GetSecretID(authenticationObj auth.AuthenticationObj, secretPath string, secretTitle string, separator string, zapLogger logging.Logger) (string, error) {
+	secretObj, err := secrets.NewSecretObj(authenticationObj, zapLogger, 0, false)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := secretObj.SecretGetSecretByPath(secretPath, secretTitle, separator, "secrets-safe/secrets")
+	if err != nil {
+		return "", err
+	}
+
+	return secret.Id, nil
+}
+
+// GetSecretVersions gets the versions of a secret, without the secret values.
+func GetSecretVersions(authenticationObj auth.AuthenticationObj, secretID string, zapLogger logging.Logger) ([]entities.SecretVersion, error) {
+	versionsUrl := authenticationObj.ApiUrl.JoinPath("secrets-safe/secrets", secretID, "versions").String()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", versionsUrl, "", "GetSecretVersions", zapLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	var secretVersions []entities.SecretVersion
+	if err = json.Unmarshal(response, &secretVersions); err != nil {
+		return nil, err
+	}
+
+	for i := range secretVersions {
+		secretVersions[i].Password = ""
+		secretVersions[i].Text = ""
+	}
+
+	return secretVersions, nil
+}
+
+// GetSecretVersionValue gets the value of a specific secret version, the password of a credential secret or the
+// text of a text secret. Versions of file secrets are not supported, the API does not return their file content.
+func GetSecretVersionValue(authenticationObj auth.AuthenticationObj, secretID string, version int, zapLogger logging.Logger) (string, error) {
+	versionUrl := authenticationObj.ApiUrl.JoinPath("secrets-safe/secrets", secretID, "versions", strconv.Itoa(version)).String()
+	response, err := callPasswordSafeAPI(authenticationObj, "GET", versionUrl, "", "GetSecretVersionValue", zapLogger)
+	if err != nil {
+		return "", err
+	}
+
+	var secretVersion entities.SecretVersion
+	if err = json.Unmarshal(response, &secretVersion); err != nil {
+		return "", err
+	}
+
+	// the secret type is not returned by every API version, it is then taken from the secret.
+	secretType := secretVersion.SecretType
+	if secretType == "" {
+		secret, err := GetSecretMetadata(authenticationObj, secretID, zapLogger)
+		if err != nil {
+			return "", err
+		}
+		secretType = secret.SecretType
+	}
+
+	switch strings.ToLower(secretType) {
+	case "credential":
+		return secretVersion.Password, nil
+	case "text":
+		return secretVersion.Text, nil
+	default:
+		return "", fmt.Errorf("values of %v secret versions are not supported, only credential and text secrets are", secretType)
+	}
+}
@@ -3,47 +3,73 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	auth "github.com/BeyondTrust/go-client-library-passwordsafe/api/authentication"
 	libentities "github.com/BeyondTrust/go-client-library-passwordsafe/api/entities"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
-	sharedAuthMu       sync.Mutex
-	sharedAuth         *auth.AuthenticationObj
-	sharedSignAppinRsp libentities.SignAppinResponse
-	sharedCacheKey     string
+	sharedAuthMu   sync.Mutex
+	sharedSessions = map[string]*sharedSession{}
 )
 
-// InitSharedAuth lazily builds the shared AuthenticationObj on first call,
-// performs the SignAppin handshake, and caches both for subsequent callers
-// that pass the same cacheKey. Both muxed providers (framework + sdkv2) call
-// this from their Configure; they receive identical provider-block config so
-// they produce the same key and the second caller hits the cache.
+// sharedSession is a signed in Password Safe session shared by every provider
+// instance configured with the same cacheKey. refs counts those instances.
+type sharedSession struct {
+	authObj   *auth.AuthenticationObj
+	signAppin libentities.SignAppinResponse
+	refs      int
+}
+
+var (
+	credentialCacheMu       sync.Mutex
+	credentialCacheSettings = map[*auth.AuthenticationObj]cacheSettings{}
+	credentialCache         = map[string]cachedCredential{}
+	credentialFetchGroup    singleflight.Group
+)
+
+// cacheSettings are the credential_cache and credential_cache_ttl settings of
+// a session. The zero value is the default, the cache is off.
+type cacheSettings struct {
+	enabled bool
+	ttl     time.Duration
+}
+
+// cachedCredential is a credential retrieved during this run. expiresAt is
+// the zero time when the cache has no TTL.
+type cachedCredential struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// InitSharedAuth returns the session of cacheKey from the session pool,
+// building the AuthenticationObj and performing the SignAppin handshake on
+// first use, and takes a reference on it. Both muxed providers (framework +
+// sdkv2) call this from their Configure; they receive identical provider-block
+// config so they produce the same key and the second caller shares the
+// session. Provider aliases pointing at other instances or API users get
+// sessions of their own, so they never sign each other out.
 //
-// When cacheKey differs from the cached one (e.g., acceptance tests rotate
-// mock-server URLs between cases) we sign the old session out and re-init.
-// The signout against the prior (often dead) server is best-effort and any
-// error is dropped — the cookie jar would be stale anyway.
+// Callers hand the reference back with ReleaseSharedAuth when they are
+// configured again with another key.
 //
-// On init failure the cached state is cleared, so the next call retries
-// rather than returning a half-built object.
+// On init failure nothing is stored, so the next call retries rather than
+// returning a half-built object.
 func InitSharedAuth(cacheKey string, build func() (*auth.AuthenticationObj, error)) (*auth.AuthenticationObj, libentities.SignAppinResponse, error) {
 	sharedAuthMu.Lock()
 	defer sharedAuthMu.Unlock()
 
-	if sharedAuth != nil && sharedCacheKey == cacheKey {
-		return sharedAuth, sharedSignAppinRsp, nil
-	}
-
-	if sharedAuth != nil {
-		_ = sharedAuth.SignOut()
+	if session, ok := sharedSessions[cacheKey]; ok {
+		session.refs++
+		return session.authObj, session.signAppin, nil
 	}
-	sharedAuth = nil
-	sharedSignAppinRsp = libentities.SignAppinResponse{}
-	sharedCacheKey = ""
 
 	authObj, err := build()
 	if err != nil {
@@ -54,30 +80,58 @@ func InitSharedAuth(cacheKey string, build func() (*auth.AuthenticationObj, erro
 		return nil, libentities.SignAppinResponse{}, err
 	}
 
-	sharedAuth = authObj
-	sharedSignAppinRsp = signAppin
-	sharedCacheKey = cacheKey
-	return sharedAuth, sharedSignAppinRsp, nil
+	EnableSessionRenewal(authObj)
+
+	sharedSessions[cacheKey] = &sharedSession{authObj: authObj, signAppin: signAppin, refs: 1}
+	return authObj, signAppin, nil
+}
+
+// ReleaseSharedAuth drops a reference taken by InitSharedAuth. The last
+// reference signs the session out and drops the credentials cached for it,
+// the sessions of other aliases keep theirs. The
+// signout against a prior (often dead) server is best-effort; its error is
+// returned for logging only.
+func ReleaseSharedAuth(cacheKey string) error {
+	sharedAuthMu.Lock()
+	defer sharedAuthMu.Unlock()
+
+	session, ok := sharedSessions[cacheKey]
+	if !ok {
+		return nil
+	}
+
+	session.refs--
+	if session.refs > 0 {
+		return nil
+	}
+
+	delete(sharedSessions, cacheKey)
+	forgetSessionCredentialCache(session.authObj)
+	return session.authObj.SignOut()
 }
 
-// ResetSharedAuthForTest clears the cached shared session without calling
-// SignOut on the (often dead) test server. Acceptance tests rotate mock
-// servers between cases; this lets them start each case from a clean state
-// without paying for an HTTP round-trip against a closed listener.
+// ResetSharedAuthForTest clears the session pool without calling SignOut on
+// the (often dead) test servers. Acceptance tests rotate mock servers between
+// cases; this lets them start each case from a clean state without paying
+// for an HTTP round-trip against a closed listener.
 //
-// Test-only. Production code never calls this — production relies on the
-// cacheKey path in InitSharedAuth to handle config changes.
+// Test-only. Production code never calls this.
 func ResetSharedAuthForTest() {
 	sharedAuthMu.Lock()
 	defer sharedAuthMu.Unlock()
-	sharedAuth = nil
-	sharedSignAppinRsp = libentities.SignAppinResponse{}
-	sharedCacheKey = ""
+	ClearCredentialCache()
+	ClearCommandCredentials()
+	sharedSessions = map[string]*sharedSession{}
+
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+	credentialCacheSettings = map[*auth.AuthenticationObj]cacheSettings{}
 }
 
-// ShutdownSharedAuth signs the shared session out of Password Safe and clears
-// the cache so a later InitSharedAuth would rebuild from scratch. Safe to
-// call multiple times — a no-op when no session is held.
+// ShutdownSharedAuth signs every pooled session out of Password Safe and
+// empties the pool so a later InitSharedAuth would rebuild from scratch.
+// Cached credentials are zeroed as well. Safe to call multiple times — a
+// no-op when no session is held.
 //
 // Called from main() after tf5server.Serve returns. Terraform may SIGKILL
 // the plugin before Serve returns cleanly; in that case the server-side
@@ -86,16 +140,195 @@ func ShutdownSharedAuth() error {
 	sharedAuthMu.Lock()
 	defer sharedAuthMu.Unlock()
 
-	if sharedAuth == nil {
-		return nil
+	ClearCredentialCache()
+
+	var errs []error
+	for cacheKey, session := range sharedSessions {
+		if err := session.authObj.SignOut(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(sharedSessions, cacheKey)
 	}
 
-	err := sharedAuth.SignOut()
-	sharedAuth = nil
-	sharedSignAppinRsp = libentities.SignAppinResponse{}
-	sharedCacheKey = ""
-	if err != nil {
-		return fmt.Errorf("shared signout: %w", err)
+	if len(errs) > 0 {
+		return fmt.Errorf("shared signout: %w", errors.Join(errs...))
 	}
 	return nil
 }
+
+// ConfigureCredentialCache turns the per-run credential cache of the session
+// in authObj on or off. The cache is off by default; both muxed providers call
+// this from Configure with the same provider-block config, provider aliases
+// keep settings of their own. A zero ttl keeps credentials for the rest of the
+// run. Changing the settings drops whatever is cached for the session.
+func ConfigureCredentialCache(authObj *auth.AuthenticationObj, enabled bool, ttl time.Duration) {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+
+	settings := cacheSettings{enabled: enabled, ttl: ttl}
+	if credentialCacheSettings[authObj] == settings {
+		return
+	}
+
+	clearSessionCredentialCacheLocked(authObj)
+	if settings == (cacheSettings{}) {
+		delete(credentialCacheSettings, authObj)
+		return
+	}
+	credentialCacheSettings[authObj] = settings
+}
+
+// ManagedAccountCacheKey builds the credential cache key of a managed account.
+// Data sources and ephemeral resources build the same key for the same lookup.
+func ManagedAccountCacheKey(systemName string, accountName string, managedAccountID int, managedSystemID int, aliasName string) string {
+	return credentialCacheKey("managed_account", systemName, accountName, strconv.Itoa(managedAccountID), strconv.Itoa(managedSystemID), aliasName)
+}
+
+// SecretCacheKey builds the credential cache key of a Secrets Safe secret.
+func SecretCacheKey(secretPath string, title string, separator string, decrypt bool) string {
+	return credentialCacheKey("secret", secretPath, title, separator, strconv.FormatBool(decrypt))
+}
+
+func credentialCacheKey(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
+
+// CredentialWarning is a warning raised while fetching a credential, such as
+// the comments of the approver of an access request.
+type CredentialWarning struct {
+	Summary string
+	Detail  string
+}
+
+// fetchedCredential is the result of a fetch shared by concurrent misses.
+type fetchedCredential struct {
+	value    string
+	warnings []CredentialWarning
+}
+
+// GetCachedCredential returns the credential cached under key, calling fetch
+// on a miss. Concurrent misses for the same key share a single fetch, so
+// several blocks referencing the same account raise one request. When the
+// cache is disabled fetch is always called. The cache lives in memory only.
+// Entries are scoped to the session in authObj, so provider aliases pointing
+// at other instances or API users never see each other's credentials.
+func GetCachedCredential(authObj *auth.AuthenticationObj, key string, fetch func() (string, error)) (string, error) {
+	value, _, err := GetCachedCredentialWithWarnings(authObj, key, func() (string, []CredentialWarning, error) {
+		value, err := fetch()
+		return value, nil, err
+	})
+	return value, err
+}
+
+// GetCachedCredentialWithWarnings is GetCachedCredential for fetches raising
+// warnings. Every caller sharing a fetch gets its warnings, a cache hit has
+// none as they were reported when the credential was fetched.
+func GetCachedCredentialWithWarnings(authObj *auth.AuthenticationObj, key string, fetch func() (string, []CredentialWarning, error)) (string, []CredentialWarning, error) {
+	if !isCredentialCacheEnabled(authObj) {
+		return fetch()
+	}
+
+	key = credentialCacheKey(fmt.Sprintf("%p", authObj), key)
+
+	if value, ok := lookupCachedCredential(key); ok {
+		return value, nil, nil
+	}
+
+	result, err, _ := credentialFetchGroup.Do(key, func() (interface{}, error) {
+		if value, ok := lookupCachedCredential(key); ok {
+			return fetchedCredential{value: value}, nil
+		}
+		value, warnings, err := fetch()
+		if err != nil {
+			return fetchedCredential{warnings: warnings}, err
+		}
+		storeCachedCredential(authObj, key, value)
+		return fetchedCredential{value: value, warnings: warnings}, nil
+	})
+	fetched := result.(fetchedCredential)
+	if err != nil {
+		return "", fetched.warnings, err
+	}
+
+	return fetched.value, fetched.warnings, nil
+}
+
+// ClearCredentialCache zeroes and drops every cached credential.
+func ClearCredentialCache() {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+	clearCredentialCacheLocked()
+}
+
+// forgetSessionCredentialCache zeroes and drops the credentials cached for the
+// session in authObj, and its cache settings.
+func forgetSessionCredentialCache(authObj *auth.AuthenticationObj) {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+
+	clearSessionCredentialCacheLocked(authObj)
+	delete(credentialCacheSettings, authObj)
+}
+
+func clearSessionCredentialCacheLocked(authObj *auth.AuthenticationObj) {
+	prefix := credentialCacheKey(fmt.Sprintf("%p", authObj), "")
+	for key, entry := range credentialCache {
+		if strings.HasPrefix(key, prefix) {
+			zeroBytes(entry.value)
+			delete(credentialCache, key)
+		}
+	}
+}
+
+func isCredentialCacheEnabled(authObj *auth.AuthenticationObj) bool {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+	return credentialCacheSettings[authObj].enabled
+}
+
+func lookupCachedCredential(key string) (string, bool) {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+
+	entry, ok := credentialCache[key]
+	if !ok {
+		return "", false
+	}
+
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		zeroBytes(entry.value)
+		delete(credentialCache, key)
+		return "", false
+	}
+
+	return string(entry.value), true
+}
+
+func storeCachedCredential(authObj *auth.AuthenticationObj, key string, value string) {
+	credentialCacheMu.Lock()
+	defer credentialCacheMu.Unlock()
+
+	settings := credentialCacheSettings[authObj]
+	if !settings.enabled {
+		return
+	}
+
+	entry := cachedCredential{value: []byte(value)}
+	if settings.ttl > 0 {
+		entry.expiresAt = time.Now().Add(settings.ttl)
+	}
+	credentialCache[key] = entry
+}
+
+func clearCredentialCacheLocked() {
+	for key, entry := range credentialCache {
+		zeroBytes(entry.value)
+		delete(credentialCache, key)
+	}
+}
+
+func zeroBytes(value []byte) {
+	for i := range value {
+		value[i] = 0
+	}
+}